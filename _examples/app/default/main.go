@@ -0,0 +1,43 @@
+package main
+
+import (
+	"time"
+
+	"atomicgo.dev/keyboard/keys"
+	"github.com/pterm/pterm"
+	"github.com/pterm/pterm/putils"
+)
+
+func main() {
+	counter := 0
+	lastKey := "-"
+
+	// Run a fullscreen application, which is rendered in the alternate screen of the terminal.
+	// The view is rendered again after every event, and when the terminal is resized.
+	err := pterm.DefaultApp.WithRefreshInterval(time.Second).Run(func(width, height int) string {
+		content := pterm.Sprintf("Counter: %d\nLast key: %s\nTerminal size: %dx%d\n\n", counter, lastKey, width, height)
+		content += pterm.Gray("Press up/down to change the counter, q to quit.")
+		box := pterm.DefaultBox.WithTitle("pterm app").Sprint(content)
+		return putils.CenterText(box)
+	}, func(event pterm.AppEvent) (bool, error) {
+		if event.Type != pterm.AppEventKey {
+			return false, nil
+		}
+
+		lastKey = event.Key.String()
+		switch {
+		case event.Key.Code == keys.Up:
+			counter++
+		case event.Key.Code == keys.Down:
+			counter--
+		case event.Key.String() == "q":
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		pterm.Error.Println(err)
+	}
+
+	pterm.Info.Printfln("The counter ended at %d.", counter)
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Render the area to stderr, so that stdout only contains the data, which can be piped to other programs.
+	area, _ := pterm.DefaultArea.WithWriter(os.Stderr).Start()
+
+	for i := 1; i <= 10; i++ {
+		// Update the live status on stderr.
+		area.Update(pterm.Sprintf("Processing record %d of 10...", i))
+
+		// Write the data to stdout.
+		fmt.Printf("record-%d\n", i)
+
+		time.Sleep(time.Millisecond * 300)
+	}
+
+	area.Stop()
+}
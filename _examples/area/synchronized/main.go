@@ -0,0 +1,29 @@
+package main
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Start a fullscreen area, which renders each update at once in terminals that support synchronized output.
+	// Only the lines, which changed since the last update, are rewritten.
+	area, _ := pterm.DefaultArea.WithFullscreen().WithSynchronizedOutput().Start()
+
+	for i := 0; i < 50; i++ {
+		// Only the values in the table change, so the header and the title are not rewritten.
+		table, _ := pterm.DefaultTable.WithHasHeader().WithData(pterm.TableData{
+			{"Service", "Requests/s", "Latency"},
+			{"api", pterm.Sprint(rand.Intn(1000)), pterm.Sprint(rand.Intn(50), "ms")},
+			{"auth", pterm.Sprint(rand.Intn(300)), pterm.Sprint(rand.Intn(20), "ms")},
+			{"search", pterm.Sprint(rand.Intn(500)), pterm.Sprint(rand.Intn(200), "ms")},
+		}).Srender()
+
+		area.Update(pterm.DefaultSection.Sprint("Dashboard") + table)
+		time.Sleep(time.Millisecond * 200)
+	}
+
+	area.Stop()
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	area, _ := pterm.DefaultArea.Start()
+
+	for i := 1; i <= 20; i++ {
+		bars := pterm.DefaultBarChart.WithBars(pterm.Bars{
+			{Label: "A", Value: i},
+			{Label: "B", Value: 20 - i},
+			{Label: "C", Value: i % 7},
+		})
+
+		// The layout is rendered again on every update, so that its content is always sized to the terminal.
+		layout := pterm.NewLayoutColumn(
+			pterm.NewLayoutText(pterm.DefaultHeader.Sprint("Dashboard")),
+			pterm.NewLayoutRow(
+				pterm.NewLayoutRender(bars).WithSize(pterm.LayoutRatio(1)).WithBox(pterm.DefaultBox.WithTitle("Load")),
+				pterm.NewLayoutFunc(func(width, height int) (string, error) {
+					return pterm.DefaultParagraph.WithMaxWidth(width).Sprint(
+						fmt.Sprintf("Update %d of 20. The paragraph wraps its text to the width of its column, which is %d cells wide.", i, width),
+					), nil
+				}).WithSize(pterm.LayoutRatio(1)).WithPadding(1),
+			),
+		)
+
+		content, _ := pterm.DefaultLayout.WithLayout(layout).Srender()
+		area.Update(content)
+		time.Sleep(time.Millisecond * 300)
+	}
+
+	area.Stop()
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Define the content of the layout. Any text or RenderPrinter can be used.
+	table := pterm.DefaultTable.WithHasHeader().WithData(pterm.TableData{
+		{"Service", "Status"},
+		{"api", "running"},
+		{"worker", "stopped"},
+	})
+	list := pterm.DefaultBulletList.WithItems([]pterm.BulletListItem{
+		{Level: 0, Text: "Deploy"},
+		{Level: 1, Text: "Build"},
+		{Level: 1, Text: "Test"},
+	})
+
+	// Arrange the content in a row with a sidebar, and two columns which share the rest of the width.
+	layout := pterm.NewLayoutRow(
+		pterm.NewLayoutText(pterm.Cyan("Sidebar")).WithSize(pterm.LayoutFixed(14)).WithBox(&pterm.DefaultBox),
+		pterm.NewLayoutRender(table).WithSize(pterm.LayoutRatio(2)).WithBox(pterm.DefaultBox.WithTitle("Services")),
+		pterm.NewLayoutRender(list).WithSize(pterm.LayoutRatio(1)).WithBox(pterm.DefaultBox.WithTitle("Tasks")),
+	)
+
+	pterm.DefaultLayout.WithLayout(layout).Render()
+}
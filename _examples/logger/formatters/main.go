@@ -0,0 +1,28 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	logger := pterm.DefaultLogger.WithLevel(pterm.LogLevelTrace)
+
+	// Print the same message with every built-in formatter.
+	for _, formatter := range []pterm.LogFormatter{
+		pterm.LogFormatterColorful,
+		pterm.LogFormatterJSON,
+		pterm.LogFormatterLogfmt,
+		pterm.LogFormatterPlain,
+	} {
+		l := logger.WithFormatter(formatter)
+		l.Info("Request handled", l.Args("method", "GET", "path", "/users", "duration", "12ms"))
+	}
+
+	// Register a custom formatter, which can be selected like the built-in ones.
+	shouting := pterm.RegisterLogFormatter(pterm.LogEntryFormatterFunc(func(l pterm.Logger, entry pterm.LogEntry) string {
+		return entry.Level.String() + ": " + strings.ToUpper(entry.Message) + "!"
+	}))
+	logger.WithFormatter(shouting).Warn("Disk almost full")
+}
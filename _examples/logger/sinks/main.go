@@ -0,0 +1,28 @@
+package main
+
+import (
+	"os"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	file, err := os.Create("app.log")
+	if err != nil {
+		pterm.Fatal.Println(err)
+	}
+	defer file.Close()
+
+	// Print colorful INFO logs to the terminal, and write JSON DEBUG logs into a file at the same time.
+	logger := pterm.DefaultLogger.WithLevel(pterm.LogLevelInfo).WithSinks(pterm.LoggerSink{
+		Writer:    file,
+		Level:     pterm.LogLevelDebug,
+		Formatter: pterm.LogFormatterJSON,
+	})
+
+	logger.Debug("Only written to the file", logger.Args("cache", "warm"))
+	logger.Info("Written to the terminal and the file", logger.Args("port", 8080))
+	logger.Warn("Disk almost full", logger.Args("free", "2GB"))
+
+	pterm.Info.Println("The debug logs were written to app.log")
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+type User struct {
+	Name  string
+	Roles []string
+}
+
+func main() {
+	// Print the stack trace of error logs.
+	logger := pterm.DefaultLogger.WithStackTrace()
+
+	// Maps, structs and slices are printed as trees.
+	logger.Info("User logged in", logger.Args(
+		"user", User{Name: "marvin", Roles: []string{"admin", "dev"}},
+		"took", 1500*time.Millisecond,
+	))
+
+	// Errors show the errors they wrap.
+	err := fmt.Errorf("load config: %w", errors.Join(
+		errors.New("file not found"),
+		fmt.Errorf("fallback: %w", errors.New("no default config")),
+	))
+	logger.Error("Could not start", logger.Args("error", err))
+
+	// The JSON formatter encodes wrapped errors and durations properly.
+	logger.WithFormatter(pterm.LogFormatterJSON).WithStackTrace(false).Error("Could not start", logger.Args("error", err, "took", time.Second))
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"context"
+
+	"github.com/pterm/pterm"
+)
+
+func handleRequest(ctx context.Context) {
+	// Get the request logger from the context. It prints the fields of the request with every message.
+	logger := pterm.LoggerFromContext(ctx)
+
+	logger.Info("Handling request")
+	logger.WithGroup("db").Debug("Querying users", logger.Args("table", "users"))
+	logger.Info("Request handled", logger.Args("status", 200))
+}
+
+func main() {
+	logger := pterm.DefaultLogger.WithLevel(pterm.LogLevelTrace).WithGroup("server")
+
+	for i, user := range []string{"marvin", "alice"} {
+		// Create a child logger with the fields of the request, and pass it to the handler in the context.
+		requestLogger := logger.With(logger.Args("request_id", i+1, "user", user))
+		handleRequest(pterm.ContextWithLogger(context.Background(), requestLogger))
+	}
+}
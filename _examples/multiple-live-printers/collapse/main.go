@@ -0,0 +1,46 @@
+package main
+
+import (
+	"io"
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Create a multi printer for managing multiple printers
+	multi := pterm.DefaultMultiPrinter
+
+	// Create a writer and a progressbar for every file
+	files := []string{"a.txt", "b.txt", "c.txt", "d.txt"}
+	writers := make([]io.Writer, len(files))
+	bars := make([]*pterm.ProgressbarPrinter, len(files))
+	for i, file := range files {
+		writers[i] = multi.NewWriter()
+		bars[i], _ = pterm.DefaultProgressbar.WithTotal(20 * (i + 1)).WithWriter(writers[i]).Start("Uploading " + file)
+	}
+
+	// Start the multi printer
+	multi.Start()
+
+	// The last file is the most important one, so move it to the top
+	multi.Move(writers[len(writers)-1], 0)
+
+	for step := 0; step < 80; step++ {
+		for i, bar := range bars {
+			if bar.Current >= bar.Total {
+				continue
+			}
+			bar.Increment()
+
+			// Move finished uploads permanently above the live area
+			if bar.Current == bar.Total {
+				multi.Collapse(writers[i])
+			}
+		}
+		time.Sleep(time.Millisecond * 50)
+	}
+
+	// Stop the multi printer
+	multi.Stop()
+}
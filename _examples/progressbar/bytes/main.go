@@ -0,0 +1,25 @@
+package main
+
+import (
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Create a progressbar for a pseudo download of 64 MiB.
+	// The current and total size are shown in bytes, together with the download rate and the remaining time.
+	p, _ := pterm.DefaultProgressbar.
+		WithTotal(64 * 1024 * 1024).
+		WithTitle("Downloading pseudo-image.iso").
+		WithUnit(pterm.ProgressbarUnitBytes).
+		WithShowRate().
+		WithShowRemainingTime().
+		Start()
+
+	// Simulate receiving chunks of 512 KiB.
+	for p.Current < p.Total {
+		p.Add(512 * 1024)
+		time.Sleep(time.Millisecond * 40)
+	}
+}
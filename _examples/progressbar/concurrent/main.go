@@ -0,0 +1,36 @@
+package main
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Create a channel with 500 pseudo jobs.
+	jobs := make(chan int, 500)
+	for i := 0; i < 500; i++ {
+		jobs <- i
+	}
+	close(jobs)
+
+	// Create a progressbar for all jobs.
+	p, _ := pterm.DefaultProgressbar.WithTotal(len(jobs)).WithTitle("Processing jobs").WithShowRate().Start()
+
+	// Process the jobs with 8 workers, which update the progressbar concurrently.
+	// The updates are combined, so the progressbar is only rendered a few times per second.
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range jobs {
+				time.Sleep(time.Duration(rand.Intn(100)) * time.Millisecond)
+				p.Increment()
+			}
+		}()
+	}
+	wg.Wait()
+}
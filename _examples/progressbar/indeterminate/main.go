@@ -0,0 +1,27 @@
+package main
+
+import (
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Create a progressbar without a total, as the number of pages isn't known in advance.
+	// The progressbar shows an animation, the number of fetched pages and the elapsed time.
+	p, _ := pterm.DefaultProgressbar.WithTotal(0).WithTitle("Fetching pages").Start()
+
+	// Simulate fetching the first pages of a paginated API.
+	for i := 0; i < 10; i++ {
+		time.Sleep(time.Millisecond * 300)
+		p.Increment()
+	}
+
+	// The API reports the total number of pages, so the progressbar switches to a determinate one.
+	p.SetTotal(25)
+
+	for p.IsActive {
+		time.Sleep(time.Millisecond * 300)
+		p.Increment()
+	}
+}
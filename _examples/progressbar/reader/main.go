@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/pterm/pterm/putils"
+)
+
+// slowReader simulates a slow data source, like a network connection.
+type slowReader struct {
+	io.Reader
+}
+
+func (r slowReader) Read(p []byte) (int, error) {
+	time.Sleep(time.Millisecond * 50)
+	return r.Reader.Read(p[:min(len(p), 4096)])
+}
+
+func main() {
+	data := strings.Repeat("pterm", 200_000)
+
+	// Track the progress of a reader, whose size is known.
+	reader, _ := putils.NewProgressbarReader(pterm.DefaultProgressbar.WithTitle("Reading data").WithShowRate(), slowReader{strings.NewReader(data)}, int64(len(data)))
+	io.Copy(io.Discard, reader)
+	reader.Close()
+
+	// Cancel the second read after two seconds, which stops the progressbar in a failed state.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancel()
+
+	reader, _ = putils.NewProgressbarReaderWithContext(ctx, pterm.DefaultProgressbar.WithTitle("Reading data with timeout"), slowReader{strings.NewReader(data)}, int64(len(data)))
+	_, err := io.Copy(io.Discard, reader)
+	reader.Close()
+
+	pterm.Error.PrintOnError(err)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Start a task group. Its progressbar shows the combined progress of all tasks.
+	group, _ := pterm.DefaultTaskGroup.Start("Building project")
+
+	// Add a spinner for a task without measurable progress.
+	spinner, _ := group.AddSpinner(pterm.DefaultSpinner, "Resolving dependencies")
+
+	// Add a nested group, which has its own combined progressbar.
+	downloads, _ := group.AddGroup("Downloading modules")
+	first, _ := downloads.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(40), "module-a")
+	second, _ := downloads.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(60), "module-b")
+
+	// Add a progressbar directly to the group.
+	compile, _ := group.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(50), "Compiling")
+
+	time.Sleep(time.Second)
+	spinner.Success("Dependencies resolved")
+
+	for i := 0; i < 100; i++ {
+		if i < 40 {
+			first.Increment()
+		}
+		if i < 60 {
+			second.Increment()
+		}
+		if i >= 50 {
+			compile.Increment()
+		}
+		time.Sleep(time.Millisecond * 50)
+	}
+
+	group.Stop()
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Create a progressbar with a custom layout.
+	// The bar fills the remaining width of the line.
+	p, _ := pterm.DefaultProgressbar.
+		WithTotal(40).
+		WithTitle("Compiling").
+		WithTemplate("{{.Title}} {{.Percentage}} {{.Bar}} {{.Current}}/{{.Total}} (ETA {{.ETA}})").
+		Start()
+
+	for i := 0; i < p.Total; i++ {
+		// Simulate a failing step.
+		if i == 30 {
+			p.Fail("Compilation failed at step ", i)
+			break
+		}
+
+		time.Sleep(time.Millisecond * 100)
+		p.Increment()
+	}
+
+	// Create a second progressbar, which finishes with a success message.
+	p, _ = pterm.DefaultProgressbar.WithTotal(20).WithTitle("Testing").Start()
+	for i := 0; i < p.Total-1; i++ {
+		time.Sleep(time.Millisecond * 100)
+		p.Increment()
+	}
+	p.Success("All tests passed")
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	logger := pterm.DefaultLogger.WithLevel(pterm.LogLevelTrace)
+
+	// Create a progressbar for some pseudo files.
+	files := []string{"config.yaml", "main.go", "README.md", "go.mod", "LICENSE"}
+	p, _ := pterm.DefaultProgressbar.WithTotal(len(files)).WithTitle("Processing files").Start()
+
+	for _, file := range files {
+		// Log messages and other output are printed above the progressbar, which is redrawn below them.
+		logger.Info("Processing file", logger.Args("file", file))
+		time.Sleep(time.Millisecond * 500)
+		pterm.Success.Println("Processed " + file)
+		p.Increment()
+	}
+}
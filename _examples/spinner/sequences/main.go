@@ -0,0 +1,16 @@
+package main
+
+import (
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Show every sequence of the built-in catalog for a short time.
+	for _, name := range pterm.SpinnerSequenceNames() {
+		spinner, _ := pterm.DefaultSpinner.WithSequenceName(name).WithDelay(time.Millisecond * 100).Start("Sequence: " + name)
+		time.Sleep(time.Second * 2)
+		spinner.Success("Sequence: " + name)
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+import "github.com/pterm/pterm"
+
+func main() {
+	// Define the data for the table.
+	tableData := pterm.TableData{
+		{"Firstname", "Lastname", "Email"},
+		{"Paul", "Dean", "augue@velitAliquam.co.uk"},
+		{"Callie", "Mckay", "nunc.sed@est.com"},
+		{"Libby", "Camacho", "lobortis@semper.com"},
+	}
+
+	// Render the table as a grid with rounded corners.
+	pterm.DefaultTable.WithHasHeader().WithBorderStyle(&pterm.TableBorderRounded).WithData(tableData).Render()
+
+	// Render the table as a grid with double lines and lines between all rows.
+	pterm.DefaultTable.WithHasHeader().WithRowSeparator("-").WithBorderStyle(&pterm.TableBorderDouble).WithData(tableData).Render()
+
+	// Render the table like a markdown table.
+	pterm.DefaultTable.WithHasHeader().WithBorderStyle(&pterm.TableBorderMarkdown).WithData(tableData).Render()
+}
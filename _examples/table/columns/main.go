@@ -0,0 +1,26 @@
+package main
+
+import "github.com/pterm/pterm"
+
+func main() {
+	// Define the data for the table.
+	// The descriptions are too long to fit into a single terminal line.
+	tableData := pterm.TableData{
+		{"ID", "Description", "Status"},
+		{"1", "Migrate the user database to the new cluster and verify that all replicas are in sync afterwards.", "done"},
+		{"2", "Update the documentation of the public API, including all examples and the changelog.", "in progress"},
+		{"3", "Remove deprecated endpoints.", "open"},
+	}
+
+	// Define the layout of each column.
+	// The ID is right-aligned, the description wraps and shrinks to fit into the terminal,
+	// and the status is centered and truncated, if it is wider than 8 characters.
+	columns := []pterm.TableColumn{
+		{Alignment: pterm.TableAlignmentRight},
+		{Overflow: pterm.TableOverflowWrap},
+		{Alignment: pterm.TableAlignmentCenter, MaxWidth: 8, Overflow: pterm.TableOverflowTruncate},
+	}
+
+	// Create a table with a header, the defined columns and the data, then render it.
+	pterm.DefaultTable.WithHasHeader().WithColumns(columns).WithData(tableData).Render()
+}
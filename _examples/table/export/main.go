@@ -0,0 +1,27 @@
+package main
+
+import "github.com/pterm/pterm"
+
+func main() {
+	// Define the data for the table.
+	tableData := pterm.TableData{
+		{"Firstname", "Lastname", "Email"},
+		{"Paul", "Dean", "augue@velitAliquam.co.uk"},
+		{"Callie", "Mckay", "nunc.sed@est.com"},
+	}
+
+	table := pterm.DefaultTable.WithHasHeader().WithData(tableData)
+
+	// Render the same table as markdown, HTML, CSV and JSON.
+	markdown, _ := table.SrenderMarkdown()
+	pterm.Println(markdown)
+
+	html, _ := table.SrenderHTML()
+	pterm.Println(html)
+
+	csv, _ := table.SrenderCSV()
+	pterm.Println(csv)
+
+	json, _ := table.SrenderJSON()
+	pterm.Println(json)
+}
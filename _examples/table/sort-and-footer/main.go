@@ -0,0 +1,39 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Define the data for the table.
+	tableData := pterm.TableData{
+		{"Item", "Quantity", "Price"},
+		{"item10", "2", "4.99"},
+		{"item2", "5", "0.50"},
+		{"item1", "1", "12.00"},
+		{"item3", "0", "7.25"},
+	}
+
+	// Sum up the quantities and show the highest price in the footer.
+	columns := []pterm.TableColumn{
+		{},
+		{Alignment: pterm.TableAlignmentRight, Aggregation: pterm.TableAggregationSum},
+		{Alignment: pterm.TableAlignmentRight, Aggregation: pterm.TableAggregationMax},
+	}
+
+	// Sort the items in natural order and hide items, which are out of stock.
+	pterm.DefaultTable.
+		WithHasHeader().
+		WithData(tableData).
+		WithColumns(columns).
+		WithBorderStyle(&pterm.TableBorderRounded).
+		WithSort(pterm.TableSort{Column: 0, Mode: pterm.TableSortNatural}).
+		WithFilter(func(row []string) bool {
+			quantity, _ := strconv.Atoi(row[1])
+			return quantity > 0
+		}).
+		WithFooterLabel("Total").
+		Render()
+}
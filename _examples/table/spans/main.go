@@ -0,0 +1,19 @@
+package main
+
+import "github.com/pterm/pterm"
+
+func main() {
+	// Define the cells of the table.
+	// "Name" spans two header rows, while "Q1" and "Q2" each span two columns.
+	// Positions covered by a cell from a previous row are skipped in the following rows.
+	cells := pterm.TableCells{
+		{{Text: "Name", RowSpan: 2}, {Text: "Q1", ColSpan: 2}, {Text: "Q2", ColSpan: 2}},
+		{{Text: "Jan"}, {Text: "Feb"}, {Text: "Apr"}, {Text: "May"}},
+		{{Text: "Paul"}, {Text: "12"}, {Text: "7"}, {Text: "9"}, {Text: "14"}},
+		{{Text: "Callie"}, {Text: "3"}, {Text: "11"}, {Text: "8"}, {Text: "5"}},
+		{{Text: "Total", ColSpan: 3, Style: pterm.NewStyle(pterm.Bold)}, {Text: "17"}, {Text: "19"}},
+	}
+
+	// Create a table with a header and the defined cells, then render it.
+	pterm.DefaultTable.WithHasHeader().WithCells(cells).Render()
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+func main() {
+	// Start a streaming table with a header.
+	// The widths of the columns are fixed from now on, so the status column gets a minimum width.
+	columns := []pterm.TableColumn{{Alignment: pterm.TableAlignmentRight}, {}, {MinWidth: 8}}
+	stream, _ := pterm.DefaultTable.
+		WithHasHeader().
+		WithBorderStyle(&pterm.TableBorderRounded).
+		WithColumns(columns).
+		WithData(pterm.TableData{{"#", "Task", "Status"}}).
+		StartStream()
+
+	// Append rows as soon as they are available.
+	for i := 1; i <= 5; i++ {
+		time.Sleep(time.Second)
+		stream.AppendRow(fmt.Sprint(i), fmt.Sprintf("Task %d", i), "done")
+	}
+
+	// Stop the stream to render the bottom border.
+	stream.Stop()
+}
@@ -0,0 +1,333 @@
+package pterm
+
+import (
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"atomicgo.dev/keyboard"
+	"atomicgo.dev/keyboard/keys"
+	"golang.org/x/term"
+
+	"github.com/pterm/pterm/internal"
+)
+
+const (
+	alternateScreenEnter = "\x1b[?1049h"
+	alternateScreenLeave = "\x1b[?1049l"
+	cursorHome           = "\x1b[H"
+	clearScreen          = "\x1b[2J"
+	hideCursor           = "\x1b[?25l"
+	showCursor           = "\x1b[?25h"
+)
+
+// DefaultApp is the default AppPrinter.
+var DefaultApp = AppPrinter{
+	AlternateScreen: true,
+	HideCursor:      true,
+	ExitKeys:        []keys.KeyCode{keys.CtrlC},
+}
+
+// AppEventType is the type of an AppEvent.
+type AppEventType int
+
+const (
+	// AppEventKey is sent, when a key is pressed.
+	AppEventKey AppEventType = iota
+	// AppEventResize is sent, when the terminal is resized.
+	AppEventResize
+	// AppEventTick is sent every RefreshInterval.
+	AppEventTick
+)
+
+// AppEvent is passed to the event handler of an AppPrinter.
+type AppEvent struct {
+	Type AppEventType
+	// Key is the pressed key of an AppEventKey.
+	Key keys.Key
+	// Width and Height are the size of the terminal, when the event occurred.
+	Width  int
+	Height int
+}
+
+// AppPrinter runs a small fullscreen terminal application.
+// It renders a view, passes keyboard, resize and tick events to a handler and re-renders the view after every event.
+// The terminal is restored when the application stops, even if it panics or is interrupted.
+type AppPrinter struct {
+	// AlternateScreen renders the application in the alternate screen buffer of the terminal,
+	// so that the previous terminal content is visible again after the application stopped.
+	AlternateScreen bool
+	// HideCursor hides the cursor while the application is running.
+	HideCursor bool
+	// ExitKeys stop the application, before they are passed to the event handler.
+	ExitKeys []keys.KeyCode
+	// RefreshInterval sends an AppEventTick in this interval. No tick events are sent, if it is zero.
+	RefreshInterval time.Duration
+	// SynchronizedOutput wraps every render in synchronized output escape sequences.
+	SynchronizedOutput bool
+
+	Writer io.Writer
+}
+
+// WithAlternateScreen renders the application in the alternate screen buffer of the terminal.
+func (p AppPrinter) WithAlternateScreen(b ...bool) *AppPrinter {
+	p.AlternateScreen = internal.WithBoolean(b)
+	return &p
+}
+
+// WithHideCursor hides the cursor while the application is running.
+func (p AppPrinter) WithHideCursor(b ...bool) *AppPrinter {
+	p.HideCursor = internal.WithBoolean(b)
+	return &p
+}
+
+// WithExitKeys sets the keys, which stop the application.
+func (p AppPrinter) WithExitKeys(exitKeys ...keys.KeyCode) *AppPrinter {
+	p.ExitKeys = exitKeys
+	return &p
+}
+
+// WithRefreshInterval sends an AppEventTick in the interval, so that the view can be animated.
+func (p AppPrinter) WithRefreshInterval(interval time.Duration) *AppPrinter {
+	p.RefreshInterval = interval
+	return &p
+}
+
+// WithSynchronizedOutput wraps every render in synchronized output escape sequences.
+func (p AppPrinter) WithSynchronizedOutput(b ...bool) *AppPrinter {
+	p.SynchronizedOutput = internal.WithBoolean(b)
+	return &p
+}
+
+// WithWriter sets the custom Writer.
+// The AppPrinter renders to the standard output, if no Writer is set.
+func (p AppPrinter) WithWriter(writer io.Writer) *AppPrinter {
+	p.Writer = writer
+	return &p
+}
+
+// Run starts the application and blocks until it stops.
+// The view is rendered with the current terminal size at the start and after every event.
+// Only as many lines as the terminal is high are shown.
+// The handler is called for every event and stops the application by returning true or an error.
+// The handler can be nil, if the application only reacts to the exit keys.
+// Run returns the error of the handler, or ErrAppInterrupted if the process received an interrupt signal.
+func (p AppPrinter) Run(view func(width, height int) string, handler func(event AppEvent) (stop bool, err error)) error {
+	writer := p.Writer
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	restore := p.enter(writer)
+	defer restore()
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupts)
+
+	resizes := make(chan struct{}, 1)
+	stopResizes := notifyResize(resizes)
+	defer stopResizes()
+
+	var ticks <-chan time.Time
+	if p.RefreshInterval > 0 {
+		ticker := time.NewTicker(p.RefreshInterval)
+		defer ticker.Stop()
+		ticks = ticker.C
+	}
+
+	area := internal.NewArea(writer)
+	area.SynchronizedOutput = p.SynchronizedOutput
+	render := func() {
+		width, height, _ := GetTerminalSize()
+		lines := strings.Split(strings.TrimRight(view(width, height), "\n"), "\n")
+		if len(lines) > height {
+			lines = lines[:height]
+		}
+		area.Update(strings.Join(lines, "\n"))
+	}
+	render()
+
+	listener := listenAppKeys()
+	defer listener.stop()
+
+	for {
+		var event AppEvent
+		select {
+		case key := <-listener.keys:
+			if p.isExitKey(key) {
+				listener.reply(true)
+				return nil
+			}
+			event = AppEvent{Type: AppEventKey, Key: key}
+		case <-resizes:
+			RecalculateTerminalSize()
+			// the content is wrapped differently after a resize, so the screen is rendered from scratch
+			_, _ = io.WriteString(writer, p.screenReset())
+			area = internal.NewArea(writer)
+			area.SynchronizedOutput = p.SynchronizedOutput
+			event = AppEvent{Type: AppEventResize}
+		case <-ticks:
+			event = AppEvent{Type: AppEventTick}
+		case <-interrupts:
+			return ErrAppInterrupted
+		case <-listener.done:
+			return listener.err
+		}
+		event.Width, event.Height, _ = GetTerminalSize()
+
+		var stop bool
+		var err error
+		if handler != nil {
+			stop, err = handler(event)
+		}
+		if event.Type == AppEventKey {
+			listener.reply(stop || err != nil)
+		}
+		if err != nil {
+			return err
+		}
+		if stop {
+			return nil
+		}
+		render()
+	}
+}
+
+// enter prepares the terminal for the application and returns a function, which restores it.
+// The returned function can be called multiple times.
+func (p AppPrinter) enter(writer io.Writer) (restore func()) {
+	// the keyboard listener puts the terminal in raw mode,
+	// which has to be reverted, even if the listener does not stop properly
+	stdin := int(os.Stdin.Fd())
+	state, _ := term.GetState(stdin)
+
+	var sb strings.Builder
+	if p.AlternateScreen {
+		sb.WriteString(alternateScreenEnter + cursorHome + clearScreen)
+	}
+	if p.HideCursor {
+		sb.WriteString(hideCursor)
+	}
+	_, _ = io.WriteString(writer, sb.String())
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			if state != nil {
+				_ = term.Restore(stdin, state)
+			}
+
+			var sb strings.Builder
+			if p.HideCursor {
+				sb.WriteString(showCursor)
+			}
+			if p.AlternateScreen {
+				sb.WriteString(alternateScreenLeave)
+			} else {
+				sb.WriteString("\n")
+			}
+			_, _ = io.WriteString(writer, sb.String())
+		})
+	}
+}
+
+// screenReset returns the escape sequences, which clear the screen and move the cursor to the start of the application.
+func (p AppPrinter) screenReset() string {
+	if p.AlternateScreen {
+		return cursorHome + clearScreen
+	}
+	return "\x1b[1G\x1b[2K"
+}
+
+// isExitKey returns true, if the key is one of the ExitKeys.
+func (p AppPrinter) isExitKey(key keys.Key) bool {
+	for _, code := range p.ExitKeys {
+		if key.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// appKeyListener passes the keys of a keyboard listener to the event loop of an AppPrinter.
+// Every key, which is received from keys, has to be answered with reply.
+type appKeyListener struct {
+	keys    chan keys.Key
+	replies chan bool
+	stopped chan struct{}
+	done    chan struct{}
+	err     error
+
+	stopOnce sync.Once
+}
+
+// listenAppKeys starts a keyboard listener in the background.
+func listenAppKeys() *appKeyListener {
+	l := &appKeyListener{
+		keys:    make(chan keys.Key),
+		replies: make(chan bool),
+		stopped: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	go func() {
+		defer close(l.done)
+		l.err = keyboard.Listen(func(key keys.Key) (stop bool, err error) {
+			// without a terminal, the listener reports empty keys continuously
+			if key.Code == keys.Null && len(key.Runes) == 0 && !key.AltPressed {
+				return l.isStopped(), nil
+			}
+
+			select {
+			case l.keys <- key:
+			case <-l.stopped:
+				return true, nil
+			}
+			select {
+			case stop = <-l.replies:
+				return stop, nil
+			case <-l.stopped:
+				return true, nil
+			}
+		})
+	}()
+
+	return l
+}
+
+// reply tells the keyboard listener, whether it should stop after the last key.
+func (l *appKeyListener) reply(stop bool) {
+	l.replies <- stop
+}
+
+// isStopped returns true, if stop was called.
+func (l *appKeyListener) isStopped() bool {
+	select {
+	case <-l.stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// stop stops the keyboard listener and waits until it returned.
+func (l *appKeyListener) stop() {
+	l.stopOnce.Do(func() { close(l.stopped) })
+
+	select {
+	case <-l.done:
+		return
+	default:
+	}
+
+	// a listener on a terminal waits for the next key press, so a key press is simulated to wake it up
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		go func() { _ = keyboard.SimulateKeyPress(keys.Null) }()
+	}
+	<-l.done
+}
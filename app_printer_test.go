@@ -0,0 +1,186 @@
+package pterm_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"atomicgo.dev/keyboard"
+	"atomicgo.dev/keyboard/keys"
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func TestAppPrinter_Run(t *testing.T) {
+	var buf bytes.Buffer
+	go func() {
+		keyboard.SimulateKeyPress('a')
+		keyboard.SimulateKeyPress('b')
+		keyboard.SimulateKeyPress('q')
+	}()
+
+	var typed string
+	err := pterm.DefaultApp.WithWriter(&buf).Run(func(width, height int) string {
+		return fmt.Sprintf("typed: %s (%dx%d)", typed, width, height)
+	}, func(event pterm.AppEvent) (bool, error) {
+		testza.AssertEqual(t, pterm.AppEventKey, event.Type)
+		testza.AssertEqual(t, terminalWidth, event.Width)
+		testza.AssertEqual(t, terminalHeight, event.Height)
+		if event.Key.String() == "q" {
+			return true, nil
+		}
+		typed += event.Key.String()
+		return false, nil
+	})
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "ab", typed)
+	testza.AssertContains(t, buf.String(), "typed: ab (80x60)")
+	testza.AssertTrue(t, strings.HasPrefix(buf.String(), "\x1b[?1049h"))
+	testza.AssertTrue(t, strings.HasSuffix(buf.String(), "\x1b[?25h\x1b[?1049l"))
+}
+
+func TestAppPrinter_Run_ExitKey(t *testing.T) {
+	var buf bytes.Buffer
+	go func() {
+		keyboard.SimulateKeyPress(keys.Escape)
+	}()
+
+	err := pterm.DefaultApp.WithWriter(&buf).WithExitKeys(keys.Escape).Run(func(width, height int) string {
+		return "Hello, World!"
+	}, func(event pterm.AppEvent) (bool, error) {
+		t.Error("exit keys should not be passed to the handler")
+		return false, nil
+	})
+
+	testza.AssertNoError(t, err)
+	testza.AssertContains(t, buf.String(), "Hello, World!")
+}
+
+func TestAppPrinter_Run_HandlerError(t *testing.T) {
+	var buf bytes.Buffer
+	go func() {
+		keyboard.SimulateKeyPress(keys.Enter)
+	}()
+
+	expected := errors.New("handler error")
+	err := pterm.DefaultApp.WithWriter(&buf).Run(func(width, height int) string {
+		return "Hello, World!"
+	}, func(event pterm.AppEvent) (bool, error) {
+		return false, expected
+	})
+
+	testza.AssertEqual(t, expected, err)
+}
+
+func TestAppPrinter_Run_Ticks(t *testing.T) {
+	var buf bytes.Buffer
+	var ticks int
+
+	err := pterm.DefaultApp.WithWriter(&buf).WithRefreshInterval(time.Millisecond).Run(func(width, height int) string {
+		return fmt.Sprintf("tick %d", ticks)
+	}, func(event pterm.AppEvent) (bool, error) {
+		testza.AssertEqual(t, pterm.AppEventTick, event.Type)
+		ticks++
+		return ticks == 3, nil
+	})
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, 3, ticks)
+	testza.AssertContains(t, buf.String(), "tick 2")
+}
+
+func TestAppPrinter_Run_LimitsViewToTerminalHeight(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := pterm.DefaultApp.WithWriter(&buf).WithRefreshInterval(time.Millisecond).Run(func(width, height int) string {
+		var lines []string
+		for i := 0; i < height+5; i++ {
+			lines = append(lines, fmt.Sprintf("line %d", i))
+		}
+		return strings.Join(lines, "\n")
+	}, func(event pterm.AppEvent) (bool, error) {
+		return true, nil
+	})
+
+	testza.AssertNoError(t, err)
+	testza.AssertContains(t, buf.String(), fmt.Sprintf("line %d", terminalHeight-1))
+	testza.AssertNotContains(t, buf.String(), fmt.Sprintf("line %d", terminalHeight))
+}
+
+func TestAppPrinter_Run_RestoresTerminalOnPanic(t *testing.T) {
+	var buf bytes.Buffer
+
+	defer func() {
+		testza.AssertEqual(t, "panic in handler", recover())
+		testza.AssertTrue(t, strings.HasSuffix(buf.String(), "\x1b[?25h\x1b[?1049l"))
+	}()
+
+	_ = pterm.DefaultApp.WithWriter(&buf).WithRefreshInterval(time.Millisecond).Run(func(width, height int) string {
+		return "Hello, World!"
+	}, func(event pterm.AppEvent) (bool, error) {
+		panic("panic in handler")
+	})
+}
+
+func TestAppPrinter_Run_WithoutAlternateScreen(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := pterm.DefaultApp.WithWriter(&buf).WithAlternateScreen(false).WithHideCursor(false).WithRefreshInterval(time.Millisecond).Run(func(width, height int) string {
+		return "Hello, World!"
+	}, func(event pterm.AppEvent) (bool, error) {
+		return true, nil
+	})
+
+	testza.AssertNoError(t, err)
+	testza.AssertNotContains(t, buf.String(), "\x1b[?1049h")
+	testza.AssertNotContains(t, buf.String(), "\x1b[?25l")
+	testza.AssertTrue(t, strings.HasSuffix(buf.String(), "Hello, World!\n"))
+}
+
+func TestAppPrinter_WithAlternateScreen(t *testing.T) {
+	p := pterm.AppPrinter{}
+	p2 := p.WithAlternateScreen()
+
+	testza.AssertTrue(t, p2.AlternateScreen)
+}
+
+func TestAppPrinter_WithHideCursor(t *testing.T) {
+	p := pterm.AppPrinter{}
+	p2 := p.WithHideCursor()
+
+	testza.AssertTrue(t, p2.HideCursor)
+}
+
+func TestAppPrinter_WithExitKeys(t *testing.T) {
+	p := pterm.AppPrinter{}
+	p2 := p.WithExitKeys(keys.Escape, keys.CtrlC)
+
+	testza.AssertEqual(t, []keys.KeyCode{keys.Escape, keys.CtrlC}, p2.ExitKeys)
+}
+
+func TestAppPrinter_WithRefreshInterval(t *testing.T) {
+	p := pterm.AppPrinter{}
+	p2 := p.WithRefreshInterval(time.Second)
+
+	testza.AssertEqual(t, time.Second, p2.RefreshInterval)
+}
+
+func TestAppPrinter_WithSynchronizedOutput(t *testing.T) {
+	p := pterm.AppPrinter{}
+	p2 := p.WithSynchronizedOutput()
+
+	testza.AssertTrue(t, p2.SynchronizedOutput)
+}
+
+func TestAppPrinter_WithWriter(t *testing.T) {
+	var buf bytes.Buffer
+	p := pterm.AppPrinter{}
+	p2 := p.WithWriter(&buf)
+
+	testza.AssertEqual(t, &buf, p2.Writer)
+}
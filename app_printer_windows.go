@@ -0,0 +1,38 @@
+//go:build windows
+
+package pterm
+
+import "time"
+
+// notifyResize sends to the channel, when the terminal is resized.
+// Windows has no resize signal, so the terminal size is polled.
+// It returns a function, which stops the notifications.
+func notifyResize(resizes chan<- struct{}) (stop func()) {
+	ticker := time.NewTicker(250 * time.Millisecond)
+	done := make(chan struct{})
+
+	go func() {
+		width, height, _ := GetTerminalSize()
+		for {
+			select {
+			case <-ticker.C:
+				w, h, _ := GetTerminalSize()
+				if w == width && h == height {
+					continue
+				}
+				width, height = w, h
+				select {
+				case resizes <- struct{}{}:
+				default:
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		ticker.Stop()
+		close(done)
+	}
+}
@@ -2,10 +2,9 @@ package pterm
 
 import (
 	"io"
+	"os"
 	"strings"
 
-	"atomicgo.dev/cursor"
-
 	"github.com/pterm/pterm/internal"
 )
 
@@ -15,19 +14,26 @@ var DefaultArea = AreaPrinter{}
 // AreaPrinter prints an area which can be updated easily.
 // use this printer for live output like charts, algorithm visualizations, simulations and even games.
 type AreaPrinter struct {
-	RemoveWhenDone bool
-	Fullscreen     bool
-	Center         bool
+	RemoveWhenDone     bool
+	Fullscreen         bool
+	Center             bool
+	SynchronizedOutput bool
 
 	content       string
+	rendered      string
 	isActive      bool
 	centerPrinter CenterPrinter
 
-	area *cursor.Area
+	area *internal.Area
+
+	Writer io.Writer
 }
 
 // GetContent returns the current area content.
 func (p *AreaPrinter) GetContent() string {
+	liveOutputMu.Lock()
+	defer liveOutputMu.Unlock()
+
 	return p.content
 }
 
@@ -43,6 +49,13 @@ func (p AreaPrinter) WithFullscreen(b ...bool) *AreaPrinter {
 	return &p
 }
 
+// WithSynchronizedOutput wraps every update of the AreaPrinter in synchronized output escape sequences.
+// Terminals, which support it, render each update at once. Other terminals ignore the escape sequences.
+func (p AreaPrinter) WithSynchronizedOutput(b ...bool) *AreaPrinter {
+	p.SynchronizedOutput = internal.WithBoolean(b)
+	return &p
+}
+
 // WithCenter centers the AreaPrinter content to the terminal.
 func (p AreaPrinter) WithCenter(centerEachLineSeparately bool, b ...bool) *AreaPrinter {
 	p.centerPrinter = CenterPrinter{
@@ -52,20 +65,31 @@ func (p AreaPrinter) WithCenter(centerEachLineSeparately bool, b ...bool) *AreaP
 	return &p
 }
 
+// WithWriter sets the custom Writer.
+// The AreaPrinter renders to the default output, which can be changed with SetDefaultOutput, if no Writer is set.
+func (p AreaPrinter) WithWriter(writer io.Writer) *AreaPrinter {
+	p.Writer = writer
+	return &p
+}
+
 // SetWriter sets the writer for the AreaPrinter.
+// If the AreaPrinter is active, the following updates are rendered to the new writer.
 func (p *AreaPrinter) SetWriter(writer io.Writer) {
+	liveOutputMu.Lock()
+	defer liveOutputMu.Unlock()
 
+	p.Writer = writer
+	if p.area != nil {
+		p.area = internal.NewArea(p.writer())
+	}
 }
 
 // Update overwrites the content of the AreaPrinter.
+// Only the lines, which changed since the last update, are rewritten.
 // Can be used live.
 func (p *AreaPrinter) Update(text ...interface{}) {
-	if p.area == nil {
-		newArea := cursor.NewArea()
-		p.area = &newArea
-	}
-	str := Sprint(text...)
-	p.content = str
+	content := Sprint(text...)
+	str := content
 
 	if p.Center {
 		str = p.centerPrinter.Sprint(str)
@@ -89,17 +113,29 @@ func (p *AreaPrinter) Update(text ...interface{}) {
 			str += strings.Repeat("\n", bottomPadding)
 		}
 	}
+
+	liveOutputMu.Lock()
+	defer liveOutputMu.Unlock()
+
+	p.content = content
+	if p.area == nil {
+		p.area = internal.NewArea(p.writer())
+	}
+	p.rendered = str
+	p.area.SynchronizedOutput = p.SynchronizedOutput
 	p.area.Update(str)
 }
 
 // Start the AreaPrinter.
 func (p *AreaPrinter) Start(text ...interface{}) (*AreaPrinter, error) {
+	liveOutputMu.Lock()
 	p.isActive = true
-	str := Sprint(text...)
-	newArea := cursor.NewArea()
-	p.area = &newArea
+	p.area = internal.NewArea(p.writer())
+	p.rendered = ""
+	activeAreaPrinters = append(activeAreaPrinters, p)
+	liveOutputMu.Unlock()
 
-	p.Update(str)
+	p.Update(Sprint(text...))
 
 	return p, nil
 }
@@ -107,12 +143,21 @@ func (p *AreaPrinter) Start(text ...interface{}) (*AreaPrinter, error) {
 // Stop terminates the AreaPrinter immediately.
 // The AreaPrinter will not resolve into anything.
 func (p *AreaPrinter) Stop() error {
+	liveOutputMu.Lock()
+	defer liveOutputMu.Unlock()
+
 	if !p.isActive {
 		return nil
 	}
 	p.isActive = false
-	if p.RemoveWhenDone {
-		p.Clear()
+	for i, area := range activeAreaPrinters {
+		if area == p {
+			activeAreaPrinters = append(activeAreaPrinters[:i:i], activeAreaPrinters[i+1:]...)
+			break
+		}
+	}
+	if p.RemoveWhenDone && p.area != nil {
+		p.area.Clear()
 	}
 	return nil
 }
@@ -139,5 +184,32 @@ func (p *AreaPrinter) GenericStop() (*LivePrinter, error) {
 // moves the cursor to the bottom of the terminal, clears n lines upwards from
 // the current position and moves the cursor again.
 func (p *AreaPrinter) Clear() {
+	liveOutputMu.Lock()
+	defer liveOutputMu.Unlock()
+
+	if p.area != nil {
+		p.area.Clear()
+	}
+}
+
+// writer returns the writer, which the AreaPrinter renders to.
+// Without a Writer, it renders to the default output, like all other printers, so that sameOutput matches it with them.
+func (p *AreaPrinter) writer() io.Writer {
+	switch {
+	case p.Writer != nil:
+		return p.Writer
+	case defaultOutput != nil:
+		return defaultOutput
+	}
+	return os.Stdout
+}
+
+// clearLive clears the content of the AreaPrinter and moves the cursor to its first line.
+func (p *AreaPrinter) clearLive() {
 	p.area.Clear()
 }
+
+// redrawLive renders the content of the AreaPrinter again, starting at the current line.
+func (p *AreaPrinter) redrawLive() {
+	p.area.Update(p.rendered)
+}
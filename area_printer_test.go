@@ -1,7 +1,9 @@
 package pterm_test
 
 import (
+	"bytes"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/MarvinJWendt/testza"
@@ -24,6 +26,7 @@ func TestAreaPrinter_GenericStart(t *testing.T) {
 
 	p := pterm.DefaultArea
 	p.GenericStart()
+	p.Stop()
 
 	os.Stdout = originalStdout // Restore original os.Stdout
 }
@@ -35,6 +38,7 @@ func TestAreaPrinter_GenericStartRawOutput(t *testing.T) {
 	pterm.DisableStyling()
 	p := pterm.DefaultArea
 	p.GenericStart()
+	p.Stop()
 	pterm.EnableStyling()
 
 	os.Stdout = originalStdout // Restore original os.Stdout
@@ -125,3 +129,85 @@ func TestAreaPrinter_Clear(t *testing.T) {
 
 	os.Stdout = originalStdout // Restore original os.Stdout
 }
+
+func TestAreaPrinter_WithWriter(t *testing.T) {
+	var buf bytes.Buffer
+	p := pterm.DefaultArea.WithWriter(&buf)
+
+	testza.AssertEqual(t, &buf, p.Writer)
+	testza.AssertZero(t, pterm.DefaultArea.Writer)
+
+	p.Start("first")
+	p.Update("second")
+	p.Stop()
+
+	out := pterm.RemoveColorFromString(buf.String())
+	testza.AssertContains(t, out, "first")
+	testza.AssertContains(t, out, "second")
+}
+
+func TestAreaPrinter_SetWriter(t *testing.T) {
+	var first, second bytes.Buffer
+	p, _ := pterm.DefaultArea.WithWriter(&first).Start("first")
+	p.SetWriter(&second)
+	p.Update("second")
+	p.Stop()
+
+	testza.AssertContains(t, first.String(), "first")
+	testza.AssertNotContains(t, first.String(), "second")
+	testza.AssertContains(t, second.String(), "second")
+}
+
+func TestAreaPrinter_PrintAboveArea(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultArea.WithWriter(&buf).Start("live\ncontent\n")
+	pterm.Fprintln(&buf, "printed")
+	p.Stop()
+
+	_, afterLine, found := strings.Cut(buf.String(), "printed\n")
+	testza.AssertTrue(t, found)
+	testza.AssertContains(t, afterLine, "live", "the area should be redrawn below the printed line")
+	testza.AssertContains(t, afterLine, "content", "the area should be redrawn below the printed line")
+}
+
+func TestMultiPrinter_WithWriter(t *testing.T) {
+	var buf bytes.Buffer
+	multi := pterm.DefaultMultiPrinter.WithWriter(&buf)
+	pterm.Fprinto(multi.NewWriter(), "entry")
+	multi.Start()
+	multi.Stop()
+
+	testza.AssertContains(t, buf.String(), "entry\r\n")
+}
+
+func TestAreaPrinter_UpdateOnlyRewritesChangedLines(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultArea.WithWriter(&buf).Start("header\nvalue: 1\nfooter")
+	buf.Reset()
+
+	p.Update("header\nvalue: 2\nfooter")
+	p.Stop()
+
+	testza.AssertContains(t, buf.String(), "value: 2")
+	testza.AssertNotContains(t, buf.String(), "header")
+}
+
+func TestAreaPrinter_UpdateWritesCarriageReturns(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultArea.WithWriter(&buf).Start("header\nvalue: 1\nfooter")
+	buf.Reset()
+
+	p.Update("header\nvalue: 2\nfooter\nextra")
+	p.Stop()
+
+	testza.AssertEqual(t, "\x1b[1A\x1b[1G\x1b[2Kvalue: 2\r\n\r\n\x1b[2Kextra", buf.String())
+}
+
+func TestAreaPrinter_WithSynchronizedOutput(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultArea.WithWriter(&buf).WithSynchronizedOutput().Start("content")
+	p.Stop()
+
+	testza.AssertTrue(t, strings.HasPrefix(buf.String(), "\x1b[?2026h"))
+	testza.AssertTrue(t, strings.HasSuffix(buf.String(), "\x1b[?2026l"))
+}
@@ -8,4 +8,10 @@ var (
 
 	// ErrHexCodeIsInvalid - the given HEX code is invalid.
 	ErrHexCodeIsInvalid = errors.New("hex code is not valid")
+
+	// ErrTaskGroupNotStarted - a task was added to a TaskGroupPrinter, which was not started.
+	ErrTaskGroupNotStarted = errors.New("task group is not started")
+
+	// ErrAppInterrupted - an AppPrinter was stopped, because the process received an interrupt signal.
+	ErrAppInterrupted = errors.New("application was interrupted")
 )
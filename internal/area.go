@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// newline moves the cursor to the start of the next line, even if the terminal does not translate line feeds,
+	// like a terminal in raw mode or a Windows console.
+	newline          = "\r\n"
+	cursorDown       = "\x1b[1B"
+	cursorLineStart  = "\x1b[1G"
+	clearCurrentLine = "\x1b[2K"
+
+	// synchronizedOutputStart and synchronizedOutputEnd make supporting terminals render everything
+	// written in between at once. Other terminals ignore them.
+	synchronizedOutputStart = "\x1b[?2026h"
+	synchronizedOutputEnd   = "\x1b[?2026l"
+)
+
+// Area renders content at the current position of a writer, which can be updated by overwriting it.
+// It uses ANSI escape sequences, so it works with any writer, which is displayed by a terminal.
+// Updates only rewrite the lines, which changed, to reduce flickering.
+type Area struct {
+	Writer io.Writer
+	// SynchronizedOutput wraps every update in synchronized output escape sequences,
+	// so that supporting terminals render the whole update at once.
+	SynchronizedOutput bool
+
+	// lines are the rendered lines. The cursor is at the end of the last line.
+	lines []string
+}
+
+// NewArea returns a new Area, which renders to the writer.
+// If the writer is a Windows console, the processing of ANSI escape sequences is enabled.
+func NewArea(writer io.Writer) *Area {
+	enableVirtualTerminalProcessing(writer)
+	return &Area{Writer: writer}
+}
+
+// Update overwrites the content of the Area.
+// Lines, which are the same as in the previous content, are not rewritten.
+func (a *Area) Update(content string) {
+	lines := strings.Split(content, "\n")
+
+	old := a.lines
+	first := 0
+	if old == nil {
+		// nothing was rendered yet, so the current line is overwritten
+		old = []string{""}
+	} else {
+		for first < len(lines) && first < len(old) && lines[first] == old[first] {
+			first++
+		}
+		if first == len(lines) && len(lines) == len(old) {
+			return
+		}
+		// the rendering starts at the latest on the line of the cursor,
+		// and the last line is always rewritten, so that the cursor ends at its end
+		first = min(first, len(old)-1, len(lines)-1)
+	}
+
+	var sb strings.Builder
+	a.writeStart(&sb)
+	writeCursorUp(&sb, len(old)-1-first)
+	sb.WriteString(cursorLineStart)
+	for i := first; i < len(lines); i++ {
+		if i == len(lines)-1 {
+			sb.WriteString(clearCurrentLine + lines[i])
+			break
+		}
+		if i >= len(old) || lines[i] != old[i] {
+			sb.WriteString(clearCurrentLine + lines[i])
+		}
+		sb.WriteString(newline)
+	}
+
+	// clear the lines of the previous content, which are below the new content
+	if extra := len(old) - len(lines); extra > 0 {
+		for i := 0; i < extra; i++ {
+			sb.WriteString(cursorDown + clearCurrentLine)
+		}
+		writeCursorUp(&sb, extra)
+	}
+	a.writeEnd(&sb)
+
+	_, _ = io.WriteString(a.Writer, sb.String())
+	a.lines = lines
+}
+
+// Clear removes the content of the Area and moves the cursor to the start of its first line.
+func (a *Area) Clear() {
+	var sb strings.Builder
+	a.writeStart(&sb)
+	sb.WriteString(cursorLineStart + clearCurrentLine)
+	for i := 1; i < len(a.lines); i++ {
+		writeCursorUp(&sb, 1)
+		sb.WriteString(clearCurrentLine)
+	}
+	sb.WriteString(cursorLineStart)
+	a.writeEnd(&sb)
+
+	_, _ = io.WriteString(a.Writer, sb.String())
+	a.lines = nil
+}
+
+// writeStart starts a synchronized update, if SynchronizedOutput is enabled.
+func (a *Area) writeStart(sb *strings.Builder) {
+	if a.SynchronizedOutput {
+		sb.WriteString(synchronizedOutputStart)
+	}
+}
+
+// writeEnd ends a synchronized update, if SynchronizedOutput is enabled.
+func (a *Area) writeEnd(sb *strings.Builder) {
+	if a.SynchronizedOutput {
+		sb.WriteString(synchronizedOutputEnd)
+	}
+}
+
+// writeCursorUp moves the cursor n lines up.
+func writeCursorUp(sb *strings.Builder, n int) {
+	if n > 0 {
+		sb.WriteString("\x1b[" + strconv.Itoa(n) + "A")
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !windows
+
+package internal
+
+import "io"
+
+// enableVirtualTerminalProcessing does nothing, as terminals on other systems always interpret ANSI escape sequences.
+func enableVirtualTerminalProcessing(io.Writer) {}
@@ -0,0 +1,99 @@
+package internal_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm/internal"
+)
+
+func TestArea_Update(t *testing.T) {
+	var buf bytes.Buffer
+	area := internal.NewArea(&buf)
+
+	area.Update("a\nb\n")
+	testza.AssertEqual(t, "\x1b[1G\x1b[2Ka\r\n\x1b[2Kb\r\n\x1b[2K", buf.String())
+}
+
+func TestArea_UpdateOnlyRewritesChangedLines(t *testing.T) {
+	var buf bytes.Buffer
+	area := internal.NewArea(&buf)
+
+	area.Update("a\nb\nc\nd")
+	buf.Reset()
+	area.Update("a\nB\nc\nd")
+
+	// the cursor moves up to the changed line, skips the unchanged line and rewrites the last line
+	testza.AssertEqual(t, "\x1b[2A\x1b[1G\x1b[2KB\r\n\r\n\x1b[2Kd", buf.String())
+}
+
+func TestArea_UpdateWritesCarriageReturns(t *testing.T) {
+	var buf bytes.Buffer
+	area := internal.NewArea(&buf)
+
+	area.Update("a\nb\nc")
+	area.Update("a\nB\nC\nd")
+
+	// every line feed is preceded by a carriage return, so that lines start in the first column without line feed translation
+	testza.AssertEqual(t, strings.Count(buf.String(), "\n"), strings.Count(buf.String(), "\r\n"))
+	testza.AssertEqual(t, 4, strings.Count(buf.String(), "\r\n"))
+}
+
+func TestArea_UpdateWithoutChanges(t *testing.T) {
+	var buf bytes.Buffer
+	area := internal.NewArea(&buf)
+
+	area.Update("a\nb")
+	buf.Reset()
+	area.Update("a\nb")
+
+	testza.AssertEqual(t, "", buf.String())
+}
+
+func TestArea_UpdateWithFewerLines(t *testing.T) {
+	var buf bytes.Buffer
+	area := internal.NewArea(&buf)
+
+	area.Update("a\nb\nc")
+	buf.Reset()
+	area.Update("a")
+
+	testza.AssertEqual(t, "\x1b[2A\x1b[1G\x1b[2Ka\x1b[1B\x1b[2K\x1b[1B\x1b[2K\x1b[2A", buf.String())
+}
+
+func TestArea_UpdateWithMoreLines(t *testing.T) {
+	var buf bytes.Buffer
+	area := internal.NewArea(&buf)
+
+	area.Update("a")
+	buf.Reset()
+	area.Update("a\nb")
+
+	testza.AssertEqual(t, "\x1b[1G\r\n\x1b[2Kb", buf.String())
+}
+
+func TestArea_SynchronizedOutput(t *testing.T) {
+	var buf bytes.Buffer
+	area := internal.NewArea(&buf)
+	area.SynchronizedOutput = true
+
+	area.Update("a")
+	testza.AssertEqual(t, "\x1b[?2026h\x1b[1G\x1b[2Ka\x1b[?2026l", buf.String())
+}
+
+func TestArea_Clear(t *testing.T) {
+	var buf bytes.Buffer
+	area := internal.NewArea(&buf)
+
+	area.Update("a\n")
+	buf.Reset()
+	area.Clear()
+	testza.AssertEqual(t, "\x1b[1G\x1b[2K\x1b[1A\x1b[2K\x1b[1G", buf.String())
+
+	buf.Reset()
+	area.Update("b")
+	testza.AssertEqual(t, "\x1b[1G\x1b[2Kb", buf.String())
+}
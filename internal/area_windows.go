@@ -0,0 +1,25 @@
+//go:build windows
+
+package internal
+
+import (
+	"io"
+
+	"golang.org/x/sys/windows"
+)
+
+// enableVirtualTerminalProcessing makes the Windows console interpret the ANSI escape sequences of the Area,
+// if the writer is a console. Other writers are not changed.
+func enableVirtualTerminalProcessing(writer io.Writer) {
+	file, ok := writer.(interface{ Fd() uintptr })
+	if !ok {
+		return
+	}
+
+	handle := windows.Handle(file.Fd())
+	var mode uint32
+	if err := windows.GetConsoleMode(handle, &mode); err != nil {
+		return
+	}
+	_ = windows.SetConsoleMode(handle, mode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING)
+}
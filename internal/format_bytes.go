@@ -0,0 +1,21 @@
+package internal
+
+import "strconv"
+
+var byteUnits = []string{"KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+// FormatBytes formats a number of bytes as a human-readable size with binary units, like "1.5 MiB".
+func FormatBytes(bytes int64) string {
+	if bytes > -1024 && bytes < 1024 {
+		return strconv.FormatInt(bytes, 10) + " B"
+	}
+
+	size := float64(bytes) / 1024
+	unit := 0
+	for (size >= 1024 || size <= -1024) && unit < len(byteUnits)-1 {
+		size /= 1024
+		unit++
+	}
+
+	return strconv.FormatFloat(size, 'f', 1, 64) + " " + byteUnits[unit]
+}
@@ -0,0 +1,18 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm/internal"
+)
+
+func TestFormatBytes(t *testing.T) {
+	testza.AssertEqual(t, "0 B", internal.FormatBytes(0))
+	testza.AssertEqual(t, "1023 B", internal.FormatBytes(1023))
+	testza.AssertEqual(t, "1.0 KiB", internal.FormatBytes(1024))
+	testza.AssertEqual(t, "1.5 KiB", internal.FormatBytes(1536))
+	testza.AssertEqual(t, "10.0 MiB", internal.FormatBytes(10*1024*1024))
+	testza.AssertEqual(t, "-2.0 GiB", internal.FormatBytes(-2*1024*1024*1024))
+	testza.AssertEqual(t, "8.0 EiB", internal.FormatBytes(1<<63-1))
+}
@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NaturalLess compares two strings in natural order.
+// Sequences of digits are compared by their numeric value, so "file2" is less than "file10".
+func NaturalLess(a, b string) bool {
+	for a != "" && b != "" {
+		chunkA, restA := nextNaturalChunk(a)
+		chunkB, restB := nextNaturalChunk(b)
+
+		if chunkA != chunkB {
+			digitsA, digitsB := isDigits(chunkA), isDigits(chunkB)
+			if digitsA && digitsB {
+				trimmedA, trimmedB := strings.TrimLeft(chunkA, "0"), strings.TrimLeft(chunkB, "0")
+				if len(trimmedA) != len(trimmedB) {
+					return len(trimmedA) < len(trimmedB)
+				}
+				if trimmedA != trimmedB {
+					return trimmedA < trimmedB
+				}
+				return len(chunkA) < len(chunkB)
+			}
+			return chunkA < chunkB
+		}
+
+		a, b = restA, restB
+	}
+
+	return a == "" && b != ""
+}
+
+// nextNaturalChunk splits s into its first chunk of either digits or non-digits and the rest.
+func nextNaturalChunk(s string) (string, string) {
+	digits := unicode.IsDigit([]rune(s)[0])
+	for i, r := range s {
+		if unicode.IsDigit(r) != digits {
+			return s[:i], s[i:]
+		}
+	}
+	return s, ""
+}
+
+// isDigits returns true if s starts with a digit.
+// It is used on chunks returned by nextNaturalChunk, which consist of either digits or non-digits only.
+func isDigits(s string) bool {
+	return s != "" && unicode.IsDigit([]rune(s)[0])
+}
@@ -0,0 +1,19 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm/internal"
+)
+
+func TestNaturalLess(t *testing.T) {
+	testza.AssertTrue(t, internal.NaturalLess("file2", "file10"))
+	testza.AssertFalse(t, internal.NaturalLess("file10", "file2"))
+	testza.AssertTrue(t, internal.NaturalLess("a", "b"))
+	testza.AssertTrue(t, internal.NaturalLess("a", "a1"))
+	testza.AssertTrue(t, internal.NaturalLess("v1.2.9", "v1.10.0"))
+	testza.AssertTrue(t, internal.NaturalLess("01", "001"))
+	testza.AssertFalse(t, internal.NaturalLess("abc", "abc"))
+	testza.AssertFalse(t, internal.NaturalLess("", ""))
+}
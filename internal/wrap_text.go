@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// WrapText wraps a single line of text, so that no line is wider than width.
+// Lines are split between words. Words that are wider than width are split into multiple lines.
+// Color codes are kept and do not count towards the width.
+func WrapText(text string, width int) []string {
+	if width <= 0 || GetStringMaxWidth(text) <= width {
+		return []string{text}
+	}
+
+	var lines []string
+	var current string
+	var currentWidth int
+
+	for _, word := range strings.Fields(text) {
+		wordWidth := GetStringMaxWidth(word)
+
+		switch {
+		case currentWidth > 0 && currentWidth+1+wordWidth <= width:
+			current += " " + word
+			currentWidth += 1 + wordWidth
+		case wordWidth <= width:
+			if currentWidth > 0 {
+				lines = append(lines, current)
+			}
+			current = word
+			currentWidth = wordWidth
+		default:
+			if currentWidth > 0 {
+				lines = append(lines, current)
+			}
+			chunks := splitByWidth(word, width)
+			lines = append(lines, chunks[:len(chunks)-1]...)
+			current = chunks[len(chunks)-1]
+			currentWidth = GetStringMaxWidth(current)
+		}
+	}
+
+	if currentWidth > 0 || len(lines) == 0 {
+		lines = append(lines, current)
+	}
+
+	return lines
+}
+
+// TruncateText shortens a single line of text to width and appends tail, if the text is wider than width.
+// The returned string, including tail, is never wider than width.
+// Color codes are kept and do not count towards the width.
+func TruncateText(text string, width int, tail string) string {
+	if width <= 0 || GetStringMaxWidth(text) <= width {
+		return text
+	}
+
+	tailWidth := runewidth.StringWidth(tail)
+	if tailWidth >= width {
+		return splitByWidth(tail, width)[0]
+	}
+
+	truncated := splitByWidth(text, width-tailWidth)[0]
+	if strings.Contains(truncated, "\x1b[") {
+		truncated += "\x1b[0m"
+	}
+
+	return truncated + tail
+}
+
+// splitByWidth splits text into chunks, which are at most width wide.
+// ANSI escape sequences are never split and do not count towards the width.
+func splitByWidth(text string, width int) []string {
+	var chunks []string
+	var current strings.Builder
+	var currentWidth int
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if r == '\x1b' && i+1 < len(runes) && runes[i+1] == '[' {
+			j := i + 2
+			for j < len(runes) && (runes[j] < 0x40 || runes[j] > 0x7e) {
+				j++
+			}
+			if j < len(runes) {
+				j++
+			}
+			current.WriteString(string(runes[i:j]))
+			i = j - 1
+			continue
+		}
+
+		w := runewidth.RuneWidth(r)
+		if currentWidth+w > width && currentWidth > 0 {
+			chunks = append(chunks, current.String())
+			current.Reset()
+			currentWidth = 0
+		}
+		current.WriteRune(r)
+		currentWidth += w
+	}
+
+	return append(chunks, current.String())
+}
@@ -0,0 +1,22 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm/internal"
+)
+
+func TestWrapText(t *testing.T) {
+	testza.AssertEqual(t, []string{"Hello World"}, internal.WrapText("Hello World", 20))
+	testza.AssertEqual(t, []string{"Hello", "World"}, internal.WrapText("Hello World", 7))
+	testza.AssertEqual(t, []string{"a", "Hello", "World"}, internal.WrapText("a HelloWorld", 5))
+	testza.AssertEqual(t, []string{"\x1b[31mHello\x1b[0m", "World"}, internal.WrapText("\x1b[31mHello\x1b[0m World", 5))
+}
+
+func TestTruncateText(t *testing.T) {
+	testza.AssertEqual(t, "Hello World", internal.TruncateText("Hello World", 20, "…"))
+	testza.AssertEqual(t, "Hell…", internal.TruncateText("Hello World", 5, "…"))
+	testza.AssertEqual(t, "..", internal.TruncateText("Hello World", 2, "..."))
+	testza.AssertEqual(t, "\x1b[31mHell\x1b[0m…", internal.TruncateText("\x1b[31mHello World\x1b[0m", 5, "…"))
+}
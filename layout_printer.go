@@ -0,0 +1,476 @@
+package pterm
+
+import (
+	"io"
+	"strings"
+
+	"github.com/pterm/pterm/internal"
+)
+
+// LayoutDirection defines how the children of a Layout are arranged.
+type LayoutDirection int
+
+const (
+	// LayoutRow arranges the children of a Layout next to each other.
+	LayoutRow LayoutDirection = iota
+	// LayoutColumn arranges the children of a Layout below each other.
+	LayoutColumn
+)
+
+// LayoutSize is the size of a Layout in the direction of its parent.
+// This is the width for children of a row and the height for children of a column.
+// The zero value sizes the Layout automatically to its content.
+type LayoutSize struct {
+	// Fixed is the size in cells.
+	Fixed int
+	// Ratio is the share of the space, which is left after the fixed and automatically sized siblings are placed.
+	Ratio int
+}
+
+// LayoutFixed returns a LayoutSize with a fixed number of cells.
+func LayoutFixed(size int) LayoutSize {
+	return LayoutSize{Fixed: size}
+}
+
+// LayoutRatio returns a LayoutSize, which shares the remaining space with its siblings in the ratio.
+// A Layout with ratio 2 gets twice the space of a sibling with ratio 1.
+func LayoutRatio(ratio int) LayoutSize {
+	return LayoutSize{Ratio: ratio}
+}
+
+// Layout is a node of a LayoutPrinter.
+// It either renders content, or arranges its children in a row or a column.
+type Layout struct {
+	Direction LayoutDirection
+	Children  []*Layout
+	// Content renders the content of a Layout without children into the given size.
+	// The height is zero, if the height of the Layout is determined by its content.
+	Content func(width, height int) (string, error)
+	Size    LayoutSize
+
+	TopPadding    int
+	RightPadding  int
+	BottomPadding int
+	LeftPadding   int
+	// Box draws a border around the Layout, if it is set.
+	Box *BoxPrinter
+}
+
+// NewLayoutRow returns a Layout, which arranges the children next to each other.
+func NewLayoutRow(children ...*Layout) *Layout {
+	return &Layout{Direction: LayoutRow, Children: children}
+}
+
+// NewLayoutColumn returns a Layout, which arranges the children below each other.
+func NewLayoutColumn(children ...*Layout) *Layout {
+	return &Layout{Direction: LayoutColumn, Children: children}
+}
+
+// NewLayoutText returns a Layout, which shows the text.
+// The output of any TextPrinter can be shown with it, by passing its Sprint result.
+func NewLayoutText(a ...interface{}) *Layout {
+	text := Sprint(a...)
+	return NewLayoutFunc(func(int, int) (string, error) {
+		return text, nil
+	})
+}
+
+// NewLayoutRender returns a Layout, which shows the output of the RenderPrinter.
+func NewLayoutRender(printer RenderPrinter) *Layout {
+	return NewLayoutFunc(func(int, int) (string, error) {
+		return printer.Srender()
+	})
+}
+
+// NewLayoutFunc returns a Layout, which shows the result of the content function.
+// The content function gets the size of the Layout, so that the content can adapt to it.
+func NewLayoutFunc(content func(width, height int) (string, error)) *Layout {
+	return &Layout{Content: content}
+}
+
+// WithSize returns a new Layout with a specific size.
+func (l Layout) WithSize(size LayoutSize) *Layout {
+	l.Size = size
+	return &l
+}
+
+// WithPadding returns a new Layout with the same padding on every side.
+func (l Layout) WithPadding(padding int) *Layout {
+	if padding < 0 {
+		padding = 0
+	}
+	l.TopPadding = padding
+	l.RightPadding = padding
+	l.BottomPadding = padding
+	l.LeftPadding = padding
+	return &l
+}
+
+// WithBox returns a new Layout, which has a border drawn by the BoxPrinter.
+func (l Layout) WithBox(box *BoxPrinter) *Layout {
+	l.Box = box
+	return &l
+}
+
+// frame returns the space, which is taken by the padding and the box of the Layout.
+func (l Layout) frame() (horizontal, vertical int) {
+	horizontal = l.LeftPadding + l.RightPadding
+	vertical = l.TopPadding + l.BottomPadding
+	if l.Box != nil {
+		horizontal += 2 + l.Box.LeftPadding + l.Box.RightPadding
+		vertical += 2 + l.Box.TopPadding + l.Box.BottomPadding
+	}
+	return horizontal, vertical
+}
+
+// layoutPass renders a tree of Layouts once.
+// It caches the sizes and contents of the Layouts, so that every Layout is measured and its content is rendered
+// only once for the same size, no matter how deeply it is nested.
+type layoutPass struct {
+	sizes    map[layoutPassKey][2]int
+	contents map[layoutPassKey][]string
+}
+
+// layoutPassKey identifies a Layout, which is measured or rendered with a specific size.
+type layoutPassKey struct {
+	layout        *Layout
+	width, height int
+}
+
+// newLayoutPass returns a layoutPass with empty caches.
+func newLayoutPass() *layoutPass {
+	return &layoutPass{
+		sizes:    make(map[layoutPassKey][2]int),
+		contents: make(map[layoutPassKey][]string),
+	}
+}
+
+// content returns the lines of the content of a Layout without children.
+func (pass *layoutPass) content(l *Layout, width, height int) ([]string, error) {
+	if l.Content == nil {
+		return nil, nil
+	}
+	key := layoutPassKey{layout: l, width: width, height: height}
+	if lines, ok := pass.contents[key]; ok {
+		return lines, nil
+	}
+
+	content, err := l.Content(width, height)
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	if content = strings.TrimRight(content, "\n"); content != "" {
+		lines = strings.Split(content, "\n")
+	}
+	pass.contents[key] = lines
+	return lines, nil
+}
+
+// measure returns the size, which the Layout needs, if it is rendered with the width and at most the height.
+// A height of zero does not limit the height.
+func (pass *layoutPass) measure(l *Layout, width, height int) (int, int, error) {
+	key := layoutPassKey{layout: l, width: width, height: height}
+	if size, ok := pass.sizes[key]; ok {
+		return size[0], size[1], nil
+	}
+
+	frameWidth, frameHeight := l.frame()
+	innerWidth := max(width-frameWidth, 0)
+	innerHeight := 0
+	if height > 0 {
+		innerHeight = max(height-frameHeight, 0)
+	}
+
+	var w, h int
+	switch {
+	case len(l.Children) == 0:
+		lines, err := pass.content(l, innerWidth, innerHeight)
+		if err != nil {
+			return 0, 0, err
+		}
+		w, h = internal.GetStringMaxWidth(strings.Join(lines, "\n")), len(lines)
+	case l.Direction == LayoutRow:
+		widths, err := l.distribute(innerWidth, func(child *Layout, available int) (int, error) {
+			w, _, err := pass.measure(child, available, innerHeight)
+			return w, err
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+		for i, child := range l.Children {
+			_, childHeight, err := pass.measure(child, widths[i], innerHeight)
+			if err != nil {
+				return 0, 0, err
+			}
+			w += widths[i]
+			h = max(h, childHeight)
+		}
+	default:
+		heights, err := pass.columnHeights(l, innerWidth, innerHeight)
+		if err != nil {
+			return 0, 0, err
+		}
+		for i, child := range l.Children {
+			childWidth, _, err := pass.measure(child, innerWidth, heights[i])
+			if err != nil {
+				return 0, 0, err
+			}
+			w = max(w, childWidth)
+			h += heights[i]
+		}
+	}
+
+	w = min(w+frameWidth, width)
+	h += frameHeight
+	if height > 0 {
+		h = min(h, height)
+	}
+	pass.sizes[key] = [2]int{w, h}
+	return w, h, nil
+}
+
+// columnHeights returns the heights of the children of a column.
+// If the height is not limited, every child gets the height, which it needs.
+func (pass *layoutPass) columnHeights(l *Layout, width, height int) ([]int, error) {
+	measureHeight := func(child *Layout, available int) (int, error) {
+		_, h, err := pass.measure(child, width, available)
+		return h, err
+	}
+	if height > 0 {
+		return l.distribute(height, measureHeight)
+	}
+
+	heights := make([]int, len(l.Children))
+	for i, child := range l.Children {
+		if child.Size.Fixed > 0 {
+			heights[i] = child.Size.Fixed
+			continue
+		}
+		h, err := measureHeight(child, 0)
+		if err != nil {
+			return nil, err
+		}
+		heights[i] = h
+	}
+	return heights, nil
+}
+
+// distribute splits the available space between the children of the Layout.
+// Children with a fixed size are placed first, then the automatically sized children, which are measured with the remaining space.
+// The space, which is left afterwards, is shared between the children with a ratio.
+func (l Layout) distribute(available int, measure func(child *Layout, available int) (int, error)) ([]int, error) {
+	sizes := make([]int, len(l.Children))
+	remaining := available
+
+	for i, child := range l.Children {
+		if child.Size.Fixed > 0 {
+			sizes[i] = min(child.Size.Fixed, remaining)
+			remaining -= sizes[i]
+		}
+	}
+
+	var ratios int
+	for i, child := range l.Children {
+		switch {
+		case child.Size.Fixed > 0:
+		case child.Size.Ratio > 0:
+			ratios += child.Size.Ratio
+		default:
+			size, err := measure(child, remaining)
+			if err != nil {
+				return nil, err
+			}
+			sizes[i] = min(size, remaining)
+			remaining -= sizes[i]
+		}
+	}
+	if ratios == 0 {
+		return sizes, nil
+	}
+
+	shared := remaining
+	for i, child := range l.Children {
+		if child.Size.Fixed <= 0 && child.Size.Ratio > 0 {
+			sizes[i] = shared * child.Size.Ratio / ratios
+			remaining -= sizes[i]
+		}
+	}
+	// the remainder of the division is given to the first children with a ratio
+	for i := 0; remaining > 0; i = (i + 1) % len(l.Children) {
+		if child := l.Children[i]; child.Size.Fixed <= 0 && child.Size.Ratio > 0 {
+			sizes[i]++
+			remaining--
+		}
+	}
+
+	return sizes, nil
+}
+
+// render renders the Layout into exactly height lines, which are exactly width cells wide.
+func (pass *layoutPass) render(l *Layout, width, height int) ([]string, error) {
+	frameWidth, frameHeight := l.frame()
+	innerWidth := max(width-frameWidth, 0)
+	innerHeight := max(height-frameHeight, 0)
+
+	var lines []string
+	switch {
+	case len(l.Children) == 0:
+		content, err := pass.content(l, innerWidth, innerHeight)
+		if err != nil {
+			return nil, err
+		}
+		lines = content
+	case l.Direction == LayoutRow:
+		widths, err := l.distribute(innerWidth, func(child *Layout, available int) (int, error) {
+			w, _, err := pass.measure(child, available, innerHeight)
+			return w, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		lines = make([]string, innerHeight)
+		for i, child := range l.Children {
+			childLines, err := pass.render(child, widths[i], innerHeight)
+			if err != nil {
+				return nil, err
+			}
+			for j, line := range childLines {
+				lines[j] += line
+			}
+		}
+	default:
+		heights, err := pass.columnHeights(l, innerWidth, innerHeight)
+		if err != nil {
+			return nil, err
+		}
+		for i, child := range l.Children {
+			childLines, err := pass.render(child, innerWidth, heights[i])
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, childLines...)
+		}
+	}
+	lines = fitLayoutLines(lines, innerWidth, innerHeight)
+
+	if l.LeftPadding > 0 || l.RightPadding > 0 {
+		for i, line := range lines {
+			lines[i] = strings.Repeat(" ", l.LeftPadding) + line + strings.Repeat(" ", l.RightPadding)
+		}
+	}
+	paddingLine := strings.Repeat(" ", innerWidth+l.LeftPadding+l.RightPadding)
+	for i := 0; i < l.TopPadding; i++ {
+		lines = append([]string{paddingLine}, lines...)
+	}
+	for i := 0; i < l.BottomPadding; i++ {
+		lines = append(lines, paddingLine)
+	}
+
+	if l.Box != nil {
+		lines = strings.Split(l.Box.Sprint(strings.Join(lines, "\n")), "\n")
+	}
+
+	return fitLayoutLines(lines, width, height), nil
+}
+
+// fitLayoutLines cuts or pads the lines, so that there are exactly height lines, which are exactly width cells wide.
+func fitLayoutLines(lines []string, width, height int) []string {
+	fitted := make([]string, height)
+	for i := range fitted {
+		var line string
+		if i < len(lines) {
+			line = lines[i]
+		}
+
+		lineWidth := internal.GetStringMaxWidth(line)
+		switch {
+		case width <= 0:
+			line = ""
+		case lineWidth > width:
+			line = internal.TruncateText(line, width, "")
+		default:
+			line += strings.Repeat(" ", width-lineWidth)
+		}
+		fitted[i] = line
+	}
+	return fitted
+}
+
+// DefaultLayout is the default LayoutPrinter.
+var DefaultLayout = LayoutPrinter{}
+
+// LayoutPrinter renders a tree of Layouts, which split the available space into rows and columns.
+// The rendered string can be printed, or used as the content of an AreaPrinter to build live dashboards.
+type LayoutPrinter struct {
+	Layout *Layout
+	// Width is the width of the rendered layout. The width of the terminal is used, if it is zero.
+	Width int
+	// Height is the height of the rendered layout. The layout is as high as its content, if it is zero.
+	Height int
+	Writer io.Writer
+}
+
+// WithLayout returns a new LayoutPrinter, which renders the Layout.
+func (p LayoutPrinter) WithLayout(layout *Layout) *LayoutPrinter {
+	p.Layout = layout
+	return &p
+}
+
+// WithWidth returns a new LayoutPrinter with a specific width.
+func (p LayoutPrinter) WithWidth(width int) *LayoutPrinter {
+	p.Width = width
+	return &p
+}
+
+// WithHeight returns a new LayoutPrinter with a specific height.
+// Use the height of the terminal, to render a fullscreen layout.
+func (p LayoutPrinter) WithHeight(height int) *LayoutPrinter {
+	p.Height = height
+	return &p
+}
+
+// WithWriter sets the custom Writer.
+func (p LayoutPrinter) WithWriter(writer io.Writer) *LayoutPrinter {
+	p.Writer = writer
+	return &p
+}
+
+// Srender renders the LayoutPrinter as a string.
+func (p LayoutPrinter) Srender() (string, error) {
+	if p.Layout == nil {
+		return "", nil
+	}
+
+	// the pass is shared between measuring and rendering, so that the content is measured only once
+	pass := newLayoutPass()
+	width := p.Width
+	if width <= 0 {
+		width = GetTerminalWidth()
+	}
+	height := p.Height
+	if height <= 0 {
+		var err error
+		_, height, err = pass.measure(p.Layout, width, 0)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	lines, err := pass.render(p.Layout, width, height)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// Render prints the LayoutPrinter to the terminal.
+func (p LayoutPrinter) Render() error {
+	s, err := p.Srender()
+	if err != nil {
+		return err
+	}
+	Fprintln(p.Writer, s)
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package pterm_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func renderLayout(t *testing.T, printer *pterm.LayoutPrinter) []string {
+	t.Helper()
+	s, err := printer.Srender()
+	testza.AssertNoError(t, err)
+	return strings.Split(pterm.RemoveColorFromString(s), "\n")
+}
+
+func TestLayoutPrinter_Srender_RowRatio(t *testing.T) {
+	layout := pterm.NewLayoutRow(
+		pterm.NewLayoutText("a").WithSize(pterm.LayoutRatio(1)),
+		pterm.NewLayoutText("b").WithSize(pterm.LayoutRatio(3)),
+	)
+
+	lines := renderLayout(t, pterm.DefaultLayout.WithLayout(layout).WithWidth(8))
+	testza.AssertEqual(t, []string{"a b     "}, lines)
+}
+
+func TestLayoutPrinter_Srender_RowFixedAndAuto(t *testing.T) {
+	layout := pterm.NewLayoutRow(
+		pterm.NewLayoutText("fixed").WithSize(pterm.LayoutFixed(3)),
+		pterm.NewLayoutText("auto"),
+		pterm.NewLayoutText("rest").WithSize(pterm.LayoutRatio(1)),
+	)
+
+	lines := renderLayout(t, pterm.DefaultLayout.WithLayout(layout).WithWidth(13))
+	testza.AssertEqual(t, []string{"fixautorest  "}, lines)
+}
+
+func TestLayoutPrinter_Srender_Column(t *testing.T) {
+	layout := pterm.NewLayoutColumn(
+		pterm.NewLayoutText("top"),
+		pterm.NewLayoutText("middle").WithSize(pterm.LayoutRatio(1)),
+		pterm.NewLayoutText("bottom").WithSize(pterm.LayoutFixed(1)),
+	)
+
+	lines := renderLayout(t, pterm.DefaultLayout.WithLayout(layout).WithWidth(6).WithHeight(5))
+	testza.AssertEqual(t, []string{"top   ", "middle", "      ", "      ", "bottom"}, lines)
+}
+
+func TestLayoutPrinter_Srender_AutoHeight(t *testing.T) {
+	layout := pterm.NewLayoutRow(
+		pterm.NewLayoutText("a\nb\nc").WithSize(pterm.LayoutRatio(1)),
+		pterm.NewLayoutText("d").WithSize(pterm.LayoutRatio(1)),
+	)
+
+	lines := renderLayout(t, pterm.DefaultLayout.WithLayout(layout).WithWidth(4))
+	testza.AssertEqual(t, []string{"a d ", "b   ", "c   "}, lines)
+}
+
+func TestLayoutPrinter_Srender_TerminalWidth(t *testing.T) {
+	lines := renderLayout(t, pterm.DefaultLayout.WithLayout(pterm.NewLayoutText("Hello, World!")))
+
+	testza.AssertLen(t, lines, 1)
+	testza.AssertEqual(t, terminalWidth, len(lines[0]))
+}
+
+func TestLayoutPrinter_Srender_Padding(t *testing.T) {
+	layout := pterm.NewLayoutText("a").WithPadding(1)
+
+	lines := renderLayout(t, pterm.DefaultLayout.WithLayout(layout).WithWidth(4))
+	testza.AssertEqual(t, []string{"    ", " a  ", "    "}, lines)
+}
+
+func TestLayoutPrinter_Srender_Box(t *testing.T) {
+	box := pterm.DefaultBox.WithVerticalString("|").WithHorizontalString("-").
+		WithTopLeftCornerString("+").WithTopRightCornerString("+").
+		WithBottomLeftCornerString("+").WithBottomRightCornerString("+")
+	layout := pterm.NewLayoutRow(
+		pterm.NewLayoutText("a").WithBox(box).WithSize(pterm.LayoutRatio(1)),
+		pterm.NewLayoutText("b").WithBox(box).WithSize(pterm.LayoutRatio(1)),
+	)
+
+	lines := renderLayout(t, pterm.DefaultLayout.WithLayout(layout).WithWidth(12))
+	testza.AssertEqual(t, []string{
+		"+----++----+",
+		"| a  || b  |",
+		"+----++----+",
+	}, lines)
+}
+
+func TestLayoutPrinter_Srender_TruncatesContent(t *testing.T) {
+	layout := pterm.NewLayoutRow(
+		pterm.NewLayoutText(pterm.Red("Hello, World!")).WithSize(pterm.LayoutFixed(5)),
+		pterm.NewLayoutText("|"),
+	)
+
+	s, err := pterm.DefaultLayout.WithLayout(layout).WithWidth(6).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Hello|", pterm.RemoveColorFromString(s))
+}
+
+func TestLayoutPrinter_Srender_ContentGetsSize(t *testing.T) {
+	var width, height int
+	layout := pterm.NewLayoutFunc(func(w, h int) (string, error) {
+		width, height = w, h
+		return "content", nil
+	}).WithPadding(1)
+
+	_, err := pterm.DefaultLayout.WithLayout(layout).WithWidth(20).WithHeight(10).Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, 18, width)
+	testza.AssertEqual(t, 8, height)
+}
+
+func TestLayoutPrinter_Srender_RenderPrinter(t *testing.T) {
+	table := pterm.DefaultTable.WithData(pterm.TableData{{"a", "b"}, {"c", "d"}})
+	layout := pterm.NewLayoutRow(pterm.NewLayoutRender(table), pterm.NewLayoutText("text"))
+
+	expected, _ := table.Srender()
+	lines := renderLayout(t, pterm.DefaultLayout.WithLayout(layout).WithWidth(40))
+	testza.AssertLen(t, lines, 2)
+	testza.AssertTrue(t, strings.HasPrefix(lines[0], strings.Split(pterm.RemoveColorFromString(expected), "\n")[0]))
+	testza.AssertContains(t, lines[0], "text")
+}
+
+func TestLayoutPrinter_Srender_MeasuresNestedLayoutsOnce(t *testing.T) {
+	var calls int
+	layout := pterm.NewLayoutFunc(func(int, int) (string, error) {
+		calls++
+		return "leaf", nil
+	})
+	for i := 0; i < 10; i++ {
+		if i%2 == 0 {
+			layout = pterm.NewLayoutRow(layout, pterm.NewLayoutText("x"))
+		} else {
+			layout = pterm.NewLayoutColumn(layout, pterm.NewLayoutText("x"))
+		}
+	}
+
+	_, err := pterm.DefaultLayout.WithLayout(layout).WithWidth(80).Srender()
+	testza.AssertNoError(t, err)
+	// without caching, the content of the leaf would be rendered 2^11 times
+	testza.AssertLess(t, calls, 64)
+}
+
+func TestLayoutPrinter_Srender_Error(t *testing.T) {
+	expected := errors.New("content error")
+	layout := pterm.NewLayoutColumn(pterm.NewLayoutFunc(func(int, int) (string, error) {
+		return "", expected
+	}))
+
+	_, err := pterm.DefaultLayout.WithLayout(layout).Srender()
+	testza.AssertEqual(t, expected, err)
+}
+
+func TestLayoutPrinter_Srender_WithoutLayout(t *testing.T) {
+	s, err := pterm.DefaultLayout.Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertZero(t, s)
+}
+
+func TestLayoutPrinter_Render(t *testing.T) {
+	printer := pterm.DefaultLayout.WithLayout(pterm.NewLayoutText("Hello, World!"))
+	content := captureStdout(func(w io.Writer) {
+		testza.AssertNoError(t, printer.Render())
+	})
+
+	testza.AssertContains(t, content, "Hello, World!")
+}
+
+func TestLayoutPrinter_WithWidth(t *testing.T) {
+	p := pterm.LayoutPrinter{}
+	p2 := p.WithWidth(1337)
+
+	testza.AssertEqual(t, 1337, p2.Width)
+}
+
+func TestLayoutPrinter_WithHeight(t *testing.T) {
+	p := pterm.LayoutPrinter{}
+	p2 := p.WithHeight(1337)
+
+	testza.AssertEqual(t, 1337, p2.Height)
+}
+
+func TestLayout_WithPadding(t *testing.T) {
+	l := pterm.Layout{}
+	l2 := l.WithPadding(2)
+
+	testza.AssertEqual(t, 2, l2.TopPadding)
+	testza.AssertEqual(t, 2, l2.RightPadding)
+	testza.AssertEqual(t, 2, l2.BottomPadding)
+	testza.AssertEqual(t, 2, l2.LeftPadding)
+}
@@ -0,0 +1,122 @@
+package pterm
+
+import (
+	"io"
+	"strings"
+	"sync"
+)
+
+// liveOutputMu serializes the output of live printers and the output, which is printed above them.
+// It is always acquired after the lock of a single live printer, never before.
+var liveOutputMu sync.Mutex
+
+// activeAreaPrinters contains all started AreaPrinters. It is guarded by liveOutputMu.
+var activeAreaPrinters []*AreaPrinter
+
+// liveRegion is an active live printer, which is rendered at the bottom of its writer.
+// Output, which is printed to the same writer, is inserted above all live regions, which are redrawn below it.
+// Both methods have to be called while liveOutputMu is locked.
+type liveRegion interface {
+	// clearLive removes the rendered output of the live printer.
+	clearLive()
+	// redrawLive renders the live printer again, after it was cleared.
+	redrawLive()
+}
+
+// printAboveLive writes s above all live regions, which render to the same writer, and redraws them afterward.
+// The write function writes s to the writer. If no live region renders to the writer, s is written as is.
+func printAboveLive(writer io.Writer, s string, write func(s string)) {
+	if s == "" {
+		return
+	}
+
+	liveOutputMu.Lock()
+	defer liveOutputMu.Unlock()
+
+	regions := activeLiveRegions(writer)
+	for i := len(regions) - 1; i >= 0; i-- {
+		regions[i].clearLive()
+	}
+
+	// the live regions are redrawn in the next line, so a line without a newline would be overwritten
+	if len(regions) != 0 && !strings.HasSuffix(s, "\n") {
+		s += "\n"
+	}
+	write(s)
+
+	for _, region := range regions {
+		region.redrawLive()
+	}
+}
+
+// fprintLive overrides the line of a live printer and stores it, so that it can be redrawn.
+func fprintLive(writer io.Writer, line *string, s string) {
+	liveOutputMu.Lock()
+	defer liveOutputMu.Unlock()
+
+	*line = s
+	Fprinto(writer, s)
+}
+
+// activeLiveRegions returns all live regions, which render to the writer, in the order they are rendered.
+// It has to be called while liveOutputMu is locked.
+func activeLiveRegions(writer io.Writer) []liveRegion {
+	var regions []liveRegion
+	for _, area := range activeAreaPrinters {
+		if sameOutput(area.writer(), writer) {
+			regions = append(regions, area)
+		}
+	}
+	for _, bar := range activeProgressBarPrinters() {
+		if sameOutput(bar.Writer, writer) {
+			regions = append(regions, bar)
+		}
+	}
+	for _, spinner := range activeSpinners() {
+		if sameOutput(spinner.Writer, writer) {
+			regions = append(regions, spinner)
+		}
+	}
+	return regions
+}
+
+// sameOutput returns true if both writers write to the same output.
+// A nil writer writes to the default output, which can be changed with SetDefaultOutput.
+func sameOutput(a, b io.Writer) bool {
+	if a == nil {
+		a = defaultOutput
+	}
+	if b == nil {
+		b = defaultOutput
+	}
+	return a == b
+}
+
+// clearLiveLine clears the current line of a single line live printer.
+func clearLiveLine(writer io.Writer) {
+	Fprinto(writer, strings.Repeat(" ", GetTerminalWidth())+"\r")
+}
+
+// clearLive clears the line of the ProgressbarPrinter.
+func (p *ProgressbarPrinter) clearLive() {
+	clearLiveLine(p.Writer)
+}
+
+// redrawLive renders the last line of the ProgressbarPrinter again.
+func (p *ProgressbarPrinter) redrawLive() {
+	if p.liveOutput != "" {
+		Fprinto(p.Writer, p.liveOutput)
+	}
+}
+
+// clearLive clears the line of the SpinnerPrinter.
+func (s *SpinnerPrinter) clearLive() {
+	clearLiveLine(s.Writer)
+}
+
+// redrawLive renders the last frame of the SpinnerPrinter again.
+func (s *SpinnerPrinter) redrawLive() {
+	if s.liveOutput != "" {
+		Fprinto(s.Writer, s.liveOutput)
+	}
+}
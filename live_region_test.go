@@ -0,0 +1,97 @@
+package pterm_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+// syncBuffer is a bytes.Buffer, which can be used by multiple goroutines.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestLiveRegion_PrintAboveProgressbar(t *testing.T) {
+	var buf syncBuffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithWriter(&buf).Start("Working")
+	defer bar.Stop()
+
+	pterm.Fprintln(&buf, "A log line")
+
+	output := buf.String()
+	_, afterLine, found := strings.Cut(output, "A log line\n")
+	testza.AssertTrue(t, found, output)
+	testza.AssertContains(t, afterLine, "Working", "the progressbar should be redrawn below the printed line")
+}
+
+func TestLiveRegion_PrintWithoutNewline(t *testing.T) {
+	var buf syncBuffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithWriter(&buf).Start("Working")
+	defer bar.Stop()
+
+	pterm.Fprint(&buf, "No newline")
+
+	testza.AssertContains(t, buf.String(), "No newline\n")
+}
+
+func TestLiveRegion_LoggerAboveSpinner(t *testing.T) {
+	var buf syncBuffer
+	spinner, _ := pterm.DefaultSpinner.WithDelay(time.Hour).WithWriter(&buf).Start("Spinning")
+	defer spinner.Stop()
+
+	// wait for the first frame of the spinner
+	for i := 0; i < 100 && !strings.Contains(buf.String(), "Spinning"); i++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	pterm.DefaultLogger.WithWriter(&buf).Info("A log message")
+	spinner.Stop()
+
+	output := buf.String()
+	_, afterLine, found := strings.Cut(output, "A log message")
+	testza.AssertTrue(t, found, output)
+	testza.AssertContains(t, afterLine, "Spinning", "the spinner should be redrawn below the log message")
+}
+
+func TestLiveRegion_OtherWritersAreNotAffected(t *testing.T) {
+	var barBuf, buf syncBuffer
+	bar, _ := pterm.DefaultProgressbar.WithTotal(10).WithWriter(&barBuf).Start("Working")
+	defer bar.Stop()
+
+	pterm.Fprint(&buf, "Unrelated")
+
+	testza.AssertEqual(t, "Unrelated", buf.String())
+}
+
+func TestLiveRegion_AreaWithoutWriterUsesDefaultOutput(t *testing.T) {
+	output := captureStdout(func(w io.Writer) {
+		area, _ := pterm.DefaultArea.Start("Area content")
+		pterm.Println("A line")
+		area.Stop()
+	})
+
+	beforeLine, afterLine, found := strings.Cut(output, "A line\n")
+	testza.AssertTrue(t, found, output)
+	testza.AssertContains(t, beforeLine, "Area content", "the area should render to the default output")
+	testza.AssertContains(t, afterLine, "Area content", "the area should be redrawn below the printed line")
+}
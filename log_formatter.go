@@ -0,0 +1,165 @@
+package pterm
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// LogEntryFormatter formats a LogEntry of a Logger into a log line.
+// The returned line must not end with a newline.
+//
+// Every formatter prints the parts of an entry in the same order:
+// time, level, scope, message, arguments, caller and stack trace.
+// The time is omitted if it is zero or the Logger does not show it, the caller and stack trace are omitted if they are empty.
+type LogEntryFormatter interface {
+	Format(logger Logger, entry LogEntry) string
+}
+
+// LogEntryFormatterFunc is a function, which implements LogEntryFormatter.
+type LogEntryFormatterFunc func(logger Logger, entry LogEntry) string
+
+// Format calls the function.
+func (f LogEntryFormatterFunc) Format(logger Logger, entry LogEntry) string {
+	return f(logger, entry)
+}
+
+var (
+	logFormattersMu sync.RWMutex
+	logFormatters   = map[LogFormatter]LogEntryFormatter{
+		LogFormatterColorful: LogEntryFormatterFunc(Logger.renderColorful),
+		LogFormatterJSON:     LogEntryFormatterFunc(Logger.renderJSON),
+		LogFormatterLogfmt:   LogEntryFormatterFunc(Logger.renderLogfmt),
+		LogFormatterPlain:    LogEntryFormatterFunc(Logger.renderPlain),
+	}
+	nextLogFormatter = LogFormatterPlain + 1
+)
+
+// RegisterLogFormatter registers a custom formatter and returns the LogFormatter, which selects it.
+// It panics, if the formatter is nil.
+//
+// Example:
+//
+//	upper := pterm.RegisterLogFormatter(pterm.LogEntryFormatterFunc(func(l pterm.Logger, e pterm.LogEntry) string {
+//		return strings.ToUpper(e.Message)
+//	}))
+//	pterm.DefaultLogger.WithFormatter(upper).Info("hello") // prints HELLO
+func RegisterLogFormatter(formatter LogEntryFormatter) LogFormatter {
+	if f, ok := formatter.(LogEntryFormatterFunc); formatter == nil || ok && f == nil {
+		panic("pterm: RegisterLogFormatter called with a nil formatter")
+	}
+
+	logFormattersMu.Lock()
+	defer logFormattersMu.Unlock()
+
+	f := nextLogFormatter
+	nextLogFormatter++
+	logFormatters[f] = formatter
+
+	return f
+}
+
+// UnregisterLogFormatter removes a formatter, which was registered with RegisterLogFormatter.
+// Loggers, which still select it, fall back to the colorful formatter. The built-in formatters can not be removed.
+func UnregisterLogFormatter(f LogFormatter) {
+	if f <= LogFormatterPlain {
+		return
+	}
+
+	logFormattersMu.Lock()
+	defer logFormattersMu.Unlock()
+
+	delete(logFormatters, f)
+}
+
+// getLogFormatter returns the formatter of the LogFormatter.
+// Unknown LogFormatters fall back to the colorful formatter.
+func getLogFormatter(f LogFormatter) LogEntryFormatter {
+	logFormattersMu.RLock()
+	defer logFormattersMu.RUnlock()
+
+	if formatter, ok := logFormatters[f]; ok {
+		return formatter
+	}
+	return logFormatters[LogFormatterColorful]
+}
+
+// renderLogfmt renders the entry as logfmt, for example: time=... level=INFO msg="hello world" key=value.
+func (l Logger) renderLogfmt(entry LogEntry) string {
+	var pairs []string
+	if l.ShowTime && !entry.Time.IsZero() {
+		pairs = append(pairs, "time="+logfmtValue(entry.Time.Format(l.TimeFormat)))
+	}
+	pairs = append(pairs, "level="+entry.Level.String())
+	if entry.Scope != "" {
+		pairs = append(pairs, "scope="+logfmtValue(entry.Scope))
+	}
+	pairs = append(pairs, "msg="+logfmtValue(entry.Message))
+	pairs = append(pairs, logfmtArgs(entry)...)
+	if len(entry.Stack) > 0 {
+		pairs = append(pairs, "stack="+logfmtValue(strings.Join(entry.Stack, "\n")))
+	}
+
+	return strings.Join(pairs, " ")
+}
+
+// renderPlain renders the entry as text without colors, for example: 2006-01-02 15:04:05 INFO  [scope] hello world key=value.
+func (l Logger) renderPlain(entry LogEntry) string {
+	var parts []string
+	if l.ShowTime && !entry.Time.IsZero() {
+		parts = append(parts, entry.Time.Format(l.TimeFormat))
+	}
+	parts = append(parts, Sprintf("%-5s", entry.Level.String()))
+	if entry.Scope != "" {
+		parts = append(parts, "["+entry.Scope+"]")
+	}
+	parts = append(parts, RemoveColorFromString(entry.Message))
+	parts = append(parts, logfmtArgs(entry)...)
+	line := strings.Join(parts, " ")
+
+	// the stack trace is printed below the log line, one frame per line
+	for _, frame := range entry.Stack {
+		line += "\n\t" + frame
+	}
+
+	return line
+}
+
+// logfmtArgs returns the arguments and the caller of the entry as key=value pairs.
+// Groups are flattened to dotted keys.
+func logfmtArgs(entry LogEntry) []string {
+	var pairs []string
+	for _, arg := range flattenLoggerArgs("", entry.Args) {
+		pairs = append(pairs, logfmtKey(arg.Key)+"="+logfmtValue(arg.Value))
+	}
+	if entry.Caller != "" {
+		pairs = append(pairs, "caller="+logfmtValue(entry.Caller))
+	}
+	return pairs
+}
+
+// logfmtKey replaces the characters, which are not allowed in logfmt keys, with underscores.
+func logfmtKey(key string) string {
+	if key == "" {
+		return "_"
+	}
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r == '=' || r == '"' || !unicode.IsPrint(r) {
+			return '_'
+		}
+		return r
+	}, key)
+}
+
+// logfmtValue returns the value without colors, quoted if necessary.
+func logfmtValue(value any) string {
+	s := RemoveColorFromString(value)
+	needsQuotes := s == "" || strings.ContainsFunc(s, func(r rune) bool {
+		return r <= ' ' || r == '=' || r == '"' || r == '\\' || !unicode.IsPrint(r)
+	})
+	if needsQuotes {
+		return strconv.Quote(s)
+	}
+	return s
+}
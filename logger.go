@@ -1,6 +1,8 @@
 package pterm
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"os"
@@ -80,7 +82,8 @@ const (
 )
 
 // LogFormatter is the log formatter.
-// Can be either LogFormatterColorful or LogFormatterJSON.
+// Can be LogFormatterColorful, LogFormatterJSON, LogFormatterLogfmt, LogFormatterPlain
+// or a custom formatter, which was registered with RegisterLogFormatter.
 type LogFormatter int
 
 const (
@@ -88,6 +91,10 @@ const (
 	LogFormatterColorful LogFormatter = iota
 	// LogFormatterJSON is a JSON log formatter.
 	LogFormatterJSON
+	// LogFormatterLogfmt is a logfmt log formatter, which prints key=value pairs.
+	LogFormatterLogfmt
+	// LogFormatterPlain is a text log formatter without colors, which is suited for log files.
+	LogFormatterPlain
 )
 
 // DefaultLogger is the default logger.
@@ -121,6 +128,8 @@ type Logger struct {
 	CallerOffset int
 	// ShowTime defines if the logger should print a timestamp.
 	ShowTime bool
+	// ShowStackTrace defines if the logger should print the stack trace of error and fatal logs.
+	ShowStackTrace bool
 	// TimestampLayout defines the layout of the timestamp.
 	TimeFormat string
 	// KeyStyles defines the styles for specific keys.
@@ -128,6 +137,25 @@ type Logger struct {
 	// MaxWidth defines the maximum width of the logger.
 	// If the text (including the arguments) is longer than the max width, it will be split into multiple lines.
 	MaxWidth int
+	// Fields are printed with every log message, before the arguments of the message.
+	Fields []LoggerArgument
+	// Scope is printed in front of every log message.
+	// It is used to tell apart the messages of different parts of a program.
+	Scope string
+	// Sinks are additional outputs of the logger.
+	// Every message is written to the Writer of the logger and to every sink, which level allows it.
+	Sinks []LoggerSink
+}
+
+// LoggerSink is an additional output of a Logger, with its own writer, level and formatter.
+// All other settings, like the time format, are taken from the Logger.
+type LoggerSink struct {
+	// Writer is the writer of the sink. Sinks without a writer are ignored.
+	Writer io.Writer
+	// Level is the minimum log level, which is written to the sink.
+	Level LogLevel
+	// Formatter is the log formatter of the sink.
+	Formatter LogFormatter
 }
 
 // WithFormatter sets the log formatter of the logger.
@@ -166,6 +194,12 @@ func (l Logger) WithTime(b ...bool) *Logger {
 	return &l
 }
 
+// WithStackTrace enables or disables the stack trace of error and fatal logs.
+func (l Logger) WithStackTrace(b ...bool) *Logger {
+	l.ShowStackTrace = internal.WithBoolean(b)
+	return &l
+}
+
 // WithTimeFormat sets the timestamp layout.
 func (l Logger) WithTimeFormat(format string) *Logger {
 	l.TimeFormat = format
@@ -198,12 +232,66 @@ func (l Logger) AppendKeyStyle(key string, style Style) *Logger {
 	return &l
 }
 
+// WithScope sets the scope, which is printed in front of every log message.
+func (l Logger) WithScope(scope string) *Logger {
+	l.Scope = scope
+	return &l
+}
+
+// With returns a child logger, which prints the arguments with every log message.
+// The arguments are added to the fields of the logger.
+//
+// Example:
+//
+//	requestLogger := logger.With(logger.Args("request_id", id))
+//	requestLogger.Info("handling request") // prints request_id
+func (l Logger) With(args ...[]LoggerArgument) *Logger {
+	l.Fields = append(l.Fields[:len(l.Fields):len(l.Fields)], l.combineArgs(args...)...)
+	return &l
+}
+
+// WithGroup returns a named child logger, which prints the name as its scope.
+// The names of nested child loggers are joined with a dot.
+func (l Logger) WithGroup(name string) *Logger {
+	if l.Scope != "" {
+		name = l.Scope + "." + name
+	}
+	l.Scope = name
+	return &l
+}
+
+// WithSinks sets the additional outputs of the logger.
+func (l Logger) WithSinks(sinks ...LoggerSink) *Logger {
+	l.Sinks = sinks
+	return &l
+}
+
+// AppendSinks adds additional outputs to the logger.
+func (l Logger) AppendSinks(sinks ...LoggerSink) *Logger {
+	l.Sinks = append(l.Sinks[:len(l.Sinks):len(l.Sinks)], sinks...)
+	return &l
+}
+
 // CanPrint checks if the logger can print a specific log level.
+// This is true, if the level of the logger or the level of any of its sinks allows it.
 func (l Logger) CanPrint(level LogLevel) bool {
-	if l.Level == LogLevelDisabled {
+	if levelAllows(l.Level, level) {
+		return true
+	}
+	for _, sink := range l.Sinks {
+		if sink.Writer != nil && levelAllows(sink.Level, level) {
+			return true
+		}
+	}
+	return false
+}
+
+// levelAllows checks if a message with the level is printed by an output with the minimum level.
+func levelAllows(minimum, level LogLevel) bool {
+	if minimum == LogLevelDisabled {
 		return false
 	}
-	return l.Level <= level
+	return minimum <= level
 }
 
 // Args converts any arguments to a slice of LoggerArgument.
@@ -243,14 +331,18 @@ func (l Logger) getCallerInfo() (path string, line int) {
 		return
 	}
 
-	_, path, line, _ = runtime.Caller(l.CallerOffset + 4)
+	_, path, line, _ = runtime.Caller(l.CallerOffset + 3)
+
+	return trimCallerPath(path), line
+}
+
+// trimCallerPath removes the directory of pterm from the path of a caller.
+func trimCallerPath(path string) string {
 	_, callerBase, _, _ := runtime.Caller(0)
 	basepath := filepath.Dir(callerBase)
 	basepath = strings.ReplaceAll(basepath, "\\", "/")
 
-	path = strings.TrimPrefix(path, basepath)
-
-	return
+	return strings.TrimPrefix(path, basepath)
 }
 
 func (l Logger) combineArgs(args ...[]LoggerArgument) []LoggerArgument {
@@ -263,29 +355,90 @@ func (l Logger) combineArgs(args ...[]LoggerArgument) []LoggerArgument {
 	return result
 }
 
+// LogEntry is a single log message, which is passed to a LogEntryFormatter.
+type LogEntry struct {
+	Level LogLevel
+	// Time is the time of the message. It is not printed, if it is zero.
+	Time    time.Time
+	Message string
+	// Args are the fields of the logger, followed by the arguments of the message.
+	Args []LoggerArgument
+	// Caller is the file and line of the caller. It is not printed, if it is empty.
+	Caller string
+	// Stack is the stack trace of the caller, with one frame per entry. It is not printed, if it is empty.
+	Stack []string
+	// Scope is the scope of the logger.
+	Scope string
+}
+
 func (l Logger) print(level LogLevel, msg string, args []LoggerArgument) {
 	if !l.CanPrint(level) {
 		return
 	}
 
-	var line string
+	entry := LogEntry{
+		Level:   level,
+		Time:    time.Now(),
+		Message: msg,
+		Args:    args,
+	}
+	if l.ShowCaller {
+		path, line := l.getCallerInfo()
+		entry.Caller = Sprintf("%s:%d", path, line)
+	}
+	if l.ShowStackTrace && (level == LogLevelError || level == LogLevelFatal) {
+		entry.Stack = l.getStackTrace()
+	}
+
+	l.printEntry(entry)
+}
+
+// printEntry formats the log entry and writes it to the writer of the logger.
+func (l Logger) printEntry(entry LogEntry) {
+	if !l.CanPrint(entry.Level) {
+		return
+	}
+	if len(l.Fields) > 0 {
+		entry.Args = append(l.Fields[:len(l.Fields):len(l.Fields)], entry.Args...)
+	}
+	entry.Scope = l.Scope
+
+	type output struct {
+		writer io.Writer
+		line   string
+	}
+	var outputs []output
 
-	switch l.Formatter {
-	case LogFormatterColorful:
-		line = l.renderColorful(level, msg, args)
-	case LogFormatterJSON:
-		line = l.renderJSON(level, msg, args)
+	if levelAllows(l.Level, entry.Level) {
+		outputs = append(outputs, output{l.Writer, getLogFormatter(l.Formatter).Format(l, entry)})
+	}
+	for _, sink := range l.Sinks {
+		if sink.Writer == nil || !levelAllows(sink.Level, entry.Level) {
+			continue
+		}
+		// the formatter of the sink sees the sink as the output of the logger
+		sinkLogger := l
+		sinkLogger.Writer, sinkLogger.Level, sinkLogger.Formatter, sinkLogger.Sinks = sink.Writer, sink.Level, sink.Formatter, nil
+		outputs = append(outputs, output{sink.Writer, getLogFormatter(sink.Formatter).Format(sinkLogger, entry)})
 	}
 
 	loggerMutex.Lock()
 	defer loggerMutex.Unlock()
 
-	_, _ = l.Writer.Write([]byte(line + "\n"))
+	for _, o := range outputs {
+		// the line is inserted above active live printers, which render to the same writer
+		printAboveLive(o.writer, o.line+"\n", func(s string) {
+			_, _ = o.writer.Write([]byte(s))
+		})
+	}
 }
 
-func (l Logger) renderColorful(level LogLevel, msg string, args []LoggerArgument) (result string) {
-	if l.ShowTime {
-		result += Gray(time.Now().Format(l.TimeFormat)) + " "
+func (l Logger) renderColorful(entry LogEntry) (result string) {
+	level, msg := entry.Level, entry.Message
+	args := flattenLoggerArgs("", entry.Args)
+
+	if l.ShowTime && !entry.Time.IsZero() {
+		result += Gray(entry.Time.Format(l.TimeFormat)) + " "
 	}
 
 	if GetTerminalWidth() > 0 && GetTerminalWidth() < l.MaxWidth {
@@ -296,6 +449,10 @@ func (l Logger) renderColorful(level LogLevel, msg string, args []LoggerArgument
 
 	result += level.Style().Sprintf("%-5s", level.String()) + " "
 
+	if entry.Scope != "" {
+		result += FgGray.Sprint("["+entry.Scope+"]") + " "
+	}
+
 	// if msg is too long, wrap it to multiple lines with the same length
 	remainingWidth := l.MaxWidth - internal.GetStringMaxWidth(result)
 	if internal.GetStringMaxWidth(msg) > remainingWidth {
@@ -307,15 +464,22 @@ func (l Logger) renderColorful(level LogLevel, msg string, args []LoggerArgument
 
 	result += msg
 
-	if l.ShowCaller {
-		path, line := l.getCallerInfo()
+	if entry.Caller != "" {
 		args = append(args, LoggerArgument{
 			Key:   "caller",
-			Value: FgGray.Sprintf("%s:%d", path, line),
+			Value: FgGray.Sprint(entry.Caller),
+		})
+	}
+	if len(entry.Stack) > 0 {
+		args = append(args, LoggerArgument{
+			Key:   "stack",
+			Value: loggerStackTrace(entry.Stack),
 		})
 	}
 
 	arguments := make([]string, len(args))
+	// argumentTrees are the lines below the arguments with complex values
+	argumentTrees := make([][]string, len(args))
 
 	// add arguments
 	if len(args) > 0 {
@@ -326,7 +490,13 @@ func (l Logger) renderColorful(level LogLevel, msg string, args []LoggerArgument
 				arguments[i] = level.Style().Sprintf("%s: ", arg.Key)
 			}
 
-			arguments[i] += Sprintf("%s", Sprint(arg.Value))
+			value, children := describeLoggerValue(arg.Value, 0)
+			arguments[i] += value
+
+			if len(children) > 0 || strings.Contains(value, "\n") {
+				argumentsInNewLine = true
+				argumentTrees[i] = renderLoggerValueTree(children, level.Style())
+			}
 		}
 	}
 
@@ -346,42 +516,136 @@ func (l Logger) renderColorful(level LogLevel, msg string, args []LoggerArgument
 		}
 
 		for i, argument := range arguments {
-			var pipe string
+			var pipe, indent string
 			if i < len(arguments)-1 {
-				pipe = "├"
+				pipe, indent = "├", "│"
 			} else {
-				pipe = "└"
+				pipe, indent = "└", " "
 			}
+			argument = strings.ReplaceAll(argument, "\n", "\n"+strings.Repeat(" ", padding)+indent+" ")
 			result += "\n" + strings.Repeat(" ", padding) + pipe + " " + argument
+			for _, line := range argumentTrees[i] {
+				result += "\n" + strings.Repeat(" ", padding) + indent + " " + line
+			}
 		}
 	}
 
 	return
 }
 
-func (l Logger) renderJSON(level LogLevel, msg string, args []LoggerArgument) string {
-	m := l.argsToMap(args)
+// renderJSON renders the entry as a JSON object.
+// The keys are written in the order of every formatter: timestamp, level, scope, msg, the arguments in call order, caller and stack.
+func (l Logger) renderJSON(entry LogEntry) string {
+	var fields loggerJSONObject
+	if l.ShowTime && !entry.Time.IsZero() {
+		fields = append(fields, LoggerArgument{Key: "timestamp", Value: entry.Time.Format(l.TimeFormat)})
+	}
+	fields = append(fields, LoggerArgument{Key: "level", Value: entry.Level.String()})
+	if entry.Scope != "" {
+		fields = append(fields, LoggerArgument{Key: "scope", Value: entry.Scope})
+	}
+	fields = append(fields, LoggerArgument{Key: "msg", Value: entry.Message})
 
-	m["level"] = level.String()
-	m["timestamp"] = time.Now().Format(l.TimeFormat)
-	m["msg"] = msg
+	var tail loggerJSONObject
+	if entry.Caller != "" {
+		tail = append(tail, LoggerArgument{Key: "caller", Value: entry.Caller})
+	}
+	if len(entry.Stack) > 0 {
+		tail = append(tail, LoggerArgument{Key: "stack", Value: entry.Stack})
+	}
 
-	if file, line := l.getCallerInfo(); file != "" {
-		m["caller"] = Sprintf("%s:%d", file, line)
+	// arguments never override the keys of the entry itself
+	reserved := make(map[string]bool, len(fields)+len(tail))
+	for _, field := range append(fields[:len(fields):len(fields)], tail...) {
+		reserved[field.Key] = true
+	}
+	for _, arg := range l.argsToJSON(entry.Args) {
+		if !reserved[arg.Key] {
+			fields = append(fields, arg)
+		}
 	}
+	fields = append(fields, tail...)
 
-	b, _ := json.Marshal(m)
+	b, _ := json.Marshal(fields)
 	return string(b)
 }
 
-func (l Logger) argsToMap(args []LoggerArgument) map[string]any {
-	m := make(map[string]any)
+// argsToJSON converts the arguments to fields of a JSON object, keeping their order.
+// Groups of arguments are converted to nested objects.
+func (l Logger) argsToJSON(args []LoggerArgument) loggerJSONObject {
+	fields := make(loggerJSONObject, 0, len(args))
 
 	for _, arg := range args {
-		m[arg.Key] = arg.Value
+		switch value := arg.Value.(type) {
+		case []LoggerArgument:
+			fields = append(fields, LoggerArgument{Key: arg.Key, Value: l.argsToJSON(value)})
+		default:
+			fields = append(fields, LoggerArgument{Key: arg.Key, Value: loggerValueToJSON(value)})
+		}
 	}
 
-	return m
+	return fields
+}
+
+// loggerJSONObject is encoded as a JSON object, which keeps the order of its fields.
+// If a key is used multiple times, the last value is written at the position of the first one.
+type loggerJSONObject []LoggerArgument
+
+// MarshalJSON encodes the fields as a JSON object.
+func (o loggerJSONObject) MarshalJSON() ([]byte, error) {
+	index := make(map[string]int, len(o))
+	fields := make([]LoggerArgument, 0, len(o))
+	for _, field := range o {
+		if i, ok := index[field.Key]; ok {
+			fields[i].Value = field.Value
+			continue
+		}
+		index[field.Key] = len(fields)
+		fields = append(fields, field)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// flattenLoggerArgs replaces groups of arguments with their arguments.
+// The keys of the grouped arguments are prefixed with the key of the group, separated by a dot.
+func flattenLoggerArgs(prefix string, args []LoggerArgument) []LoggerArgument {
+	var result []LoggerArgument
+
+	for _, arg := range args {
+		key := arg.Key
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if group, ok := arg.Value.([]LoggerArgument); ok {
+			result = append(result, flattenLoggerArgs(key, group)...)
+			continue
+		}
+		result = append(result, LoggerArgument{Key: key, Value: arg.Value})
+	}
+
+	return result
 }
 
 // Trace prints a trace log.
@@ -427,5 +691,25 @@ type LoggerArgument struct {
 	// Key is the key of the argument.
 	Key string
 	// Value is the value of the argument.
+	// A value of type []LoggerArgument is a group of arguments.
 	Value any
 }
+
+// loggerContextKey is the key of the logger in a context.Context.
+type loggerContextKey struct{}
+
+// ContextWithLogger returns a copy of the context, which carries the logger.
+// Use LoggerFromContext to get the logger back, for example in request handlers.
+func ContextWithLogger(ctx context.Context, logger *Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, logger)
+}
+
+// LoggerFromContext returns the logger of the context.
+// If the context does not carry a logger, the DefaultLogger is returned.
+func LoggerFromContext(ctx context.Context) *Logger {
+	if logger, ok := ctx.Value(loggerContextKey{}).(*Logger); ok && logger != nil {
+		return logger
+	}
+	logger := DefaultLogger
+	return &logger
+}
@@ -0,0 +1,328 @@
+package pterm_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func TestLogger_With(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithTime(false)
+	child := logger.With(logger.Args("request_id", 1337))
+
+	child.Info("message", child.Args("user", "marvin"))
+	logger.Info("parent")
+
+	testza.AssertEqual(t, "INFO  message request_id: 1337 user: marvin\nINFO  parent \n", pterm.RemoveColorFromString(buf.String()))
+}
+
+func TestLogger_With_DoesNotShareFields(t *testing.T) {
+	logger := pterm.DefaultLogger.With(pterm.DefaultLogger.Args("a", 1))
+	child1 := logger.With(logger.Args("b", 2))
+	child2 := logger.With(logger.Args("c", 3))
+
+	testza.AssertEqual(t, []pterm.LoggerArgument{{Key: "a", Value: 1}}, logger.Fields)
+	testza.AssertEqual(t, []pterm.LoggerArgument{{Key: "a", Value: 1}, {Key: "b", Value: 2}}, child1.Fields)
+	testza.AssertEqual(t, []pterm.LoggerArgument{{Key: "a", Value: 1}, {Key: "c", Value: 3}}, child2.Fields)
+}
+
+func TestLogger_WithGroup(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithTime(false).WithGroup("server").WithGroup("db")
+
+	logger.Info("connected")
+
+	testza.AssertEqual(t, "server.db", logger.Scope)
+	testza.AssertEqual(t, "INFO  [server.db] connected \n", pterm.RemoveColorFromString(buf.String()))
+}
+
+func TestLogger_WithGroup_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithFormatter(pterm.LogFormatterJSON).WithGroup("server")
+
+	logger.With(logger.Args("a", "b")).Info("connected")
+
+	var m map[string]any
+	testza.AssertNoError(t, json.Unmarshal(buf.Bytes(), &m))
+	testza.AssertEqual(t, "server", m["scope"])
+	testza.AssertEqual(t, "b", m["a"])
+	testza.AssertEqual(t, "connected", m["msg"])
+}
+
+func TestLoggerFromContext(t *testing.T) {
+	logger := pterm.DefaultLogger.WithScope("request")
+	ctx := pterm.ContextWithLogger(context.Background(), logger)
+
+	testza.AssertEqual(t, logger, pterm.LoggerFromContext(ctx))
+}
+
+func TestLoggerFromContext_Default(t *testing.T) {
+	logger := pterm.LoggerFromContext(context.Background())
+
+	testza.AssertEqual(t, pterm.DefaultLogger.Level, logger.Level)
+	testza.AssertEqual(t, pterm.DefaultLogger.Formatter, logger.Formatter)
+}
+
+func TestLogger_LogfmtFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithFormatter(pterm.LogFormatterLogfmt).WithTime(false).WithScope("db")
+
+	logger.Info("hello world", logger.Args("key", "value", "quoted", `a "b"`, "empty", "", "colored", pterm.Red("red")))
+
+	testza.AssertEqual(t, `level=INFO scope=db msg="hello world" key=value quoted="a \"b\"" empty="" colored=red`+"\n", buf.String())
+}
+
+func TestLogger_PlainFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithFormatter(pterm.LogFormatterPlain).WithTime(false)
+
+	logger.Warn(pterm.Red("hello world"), logger.Args("key", "value with spaces"))
+
+	testza.AssertEqual(t, `WARN  hello world key="value with spaces"`+"\n", buf.String())
+}
+
+func TestLogger_JSONFormatterKeyOrder(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithFormatter(pterm.LogFormatterJSON).WithTimeFormat("15:04").WithCaller().WithScope("db")
+
+	logger.Info("message", logger.Args("zeta", 1, "alpha", logger.Args("y", 2, "b", 3)))
+
+	testza.AssertRegexp(t, `^\{"timestamp":"\d\d:\d\d","level":"INFO","scope":"db","msg":"message","zeta":1,"alpha":\{"y":2,"b":3\},"caller":"[^"]*logger_test.go:\d+"\}\n`, buf.String())
+}
+
+func TestLogger_JSONFormatterWithoutTime(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithFormatter(pterm.LogFormatterJSON).WithTime(false)
+
+	logger.Info("message", logger.Args("level", "overridden", "zeta", 1, "zeta", 2, "alpha", 3))
+
+	testza.AssertEqual(t, `{"level":"INFO","msg":"message","zeta":2,"alpha":3}`+"\n", buf.String())
+}
+
+func TestLogger_FormattersPrintTimeAndCaller(t *testing.T) {
+	for _, formatter := range []pterm.LogFormatter{pterm.LogFormatterColorful, pterm.LogFormatterJSON, pterm.LogFormatterLogfmt, pterm.LogFormatterPlain} {
+		var buf bytes.Buffer
+		logger := pterm.DefaultLogger.WithWriter(&buf).WithFormatter(formatter).WithTimeFormat("15:04:05.000000").WithCaller()
+
+		logger.Info("message")
+
+		testza.AssertContains(t, buf.String(), "logger_test.go:")
+		testza.AssertRegexp(t, `\d\d:\d\d:\d\d\.\d{6}`, buf.String())
+	}
+}
+
+func TestRegisterLogFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := pterm.RegisterLogFormatter(pterm.LogEntryFormatterFunc(func(l pterm.Logger, e pterm.LogEntry) string {
+		return e.Level.String() + "|" + e.Message + "|" + e.Args[0].Key
+	}))
+	t.Cleanup(func() { pterm.UnregisterLogFormatter(formatter) })
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithFormatter(formatter).WithTime(false)
+
+	logger.With(logger.Args("field", 1)).Error("message")
+
+	testza.AssertEqual(t, "ERROR|message|field\n", buf.String())
+
+	other := pterm.RegisterLogFormatter(pterm.LogEntryFormatterFunc(func(l pterm.Logger, e pterm.LogEntry) string { return "" }))
+	t.Cleanup(func() { pterm.UnregisterLogFormatter(other) })
+	testza.AssertNotEqual(t, formatter, other)
+}
+
+func TestRegisterLogFormatter_Nil(t *testing.T) {
+	testza.AssertPanics(t, func() { pterm.RegisterLogFormatter(nil) })
+	testza.AssertPanics(t, func() { pterm.RegisterLogFormatter(pterm.LogEntryFormatterFunc(nil)) })
+}
+
+func TestUnregisterLogFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := pterm.RegisterLogFormatter(pterm.LogEntryFormatterFunc(func(l pterm.Logger, e pterm.LogEntry) string {
+		return "custom"
+	}))
+	pterm.UnregisterLogFormatter(formatter)
+	pterm.UnregisterLogFormatter(pterm.LogFormatterJSON)
+
+	pterm.DefaultLogger.WithWriter(&buf).WithFormatter(formatter).WithTime(false).Info("message")
+	pterm.DefaultLogger.WithWriter(&buf).WithFormatter(pterm.LogFormatterJSON).WithTime(false).Info("message")
+
+	testza.AssertEqual(t, "INFO  message \n"+`{"level":"INFO","msg":"message"}`+"\n", pterm.RemoveColorFromString(buf.String()))
+}
+
+func TestLogger_Sinks(t *testing.T) {
+	var terminal, file bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&terminal).WithTime(false).WithLevel(pterm.LogLevelInfo).
+		WithSinks(pterm.LoggerSink{Writer: &file, Level: pterm.LogLevelDebug, Formatter: pterm.LogFormatterLogfmt})
+
+	logger.Debug("debug")
+	logger.Info("info", logger.Args("key", "value"))
+
+	testza.AssertEqual(t, "INFO  info key: value\n", pterm.RemoveColorFromString(terminal.String()))
+	testza.AssertEqual(t, "level=DEBUG msg=debug\nlevel=INFO msg=info key=value\n", file.String())
+}
+
+func TestLogger_Sinks_DisabledLogger(t *testing.T) {
+	var terminal, file bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&terminal).WithLevel(pterm.LogLevelDisabled).
+		WithSinks(pterm.LoggerSink{Writer: &file, Level: pterm.LogLevelWarn, Formatter: pterm.LogFormatterJSON})
+
+	testza.AssertFalse(t, logger.CanPrint(pterm.LogLevelInfo))
+	testza.AssertTrue(t, logger.CanPrint(pterm.LogLevelWarn))
+
+	logger.Info("info")
+	logger.Error("error")
+
+	testza.AssertZero(t, terminal.String())
+	var m map[string]any
+	testza.AssertNoError(t, json.Unmarshal(file.Bytes(), &m))
+	testza.AssertEqual(t, "error", m["msg"])
+}
+
+func TestLogger_Sinks_WithoutWriter(t *testing.T) {
+	logger := pterm.DefaultLogger.WithLevel(pterm.LogLevelDisabled).WithSinks(pterm.LoggerSink{Level: pterm.LogLevelTrace})
+
+	testza.AssertFalse(t, logger.CanPrint(pterm.LogLevelError))
+}
+
+func TestLogger_AppendSinks(t *testing.T) {
+	logger := pterm.DefaultLogger.WithSinks(pterm.LoggerSink{Level: pterm.LogLevelDebug})
+	logger2 := logger.AppendSinks(pterm.LoggerSink{Level: pterm.LogLevelError})
+
+	testza.AssertLen(t, logger.Sinks, 1)
+	testza.AssertLen(t, logger2.Sinks, 2)
+}
+
+func TestLogger_Sinks_SlogHandler(t *testing.T) {
+	var file bytes.Buffer
+	logger := pterm.DefaultLogger.WithLevel(pterm.LogLevelError).
+		WithSinks(pterm.LoggerSink{Writer: &file, Level: pterm.LogLevelDebug, Formatter: pterm.LogFormatterPlain})
+
+	slog.New(pterm.NewSlogHandler(logger)).Debug("debug")
+
+	testza.AssertContains(t, file.String(), "DEBUG debug")
+}
+
+type loggerTestUser struct {
+	Name  string
+	Roles []string
+	age   int
+}
+
+func TestLogger_ErrorChain(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithTime(false)
+	err := fmt.Errorf("load config: %w", errors.Join(errors.New("file not found"), fmt.Errorf("fallback: %w", errors.New("no default"))))
+
+	logger.Error("failed", logger.Args("error", err))
+
+	testza.AssertEqual(t, strings.Join([]string{
+		"ERROR failed",
+		"    └ error: load config: file not found",
+		"      fallback: no default",
+		"      ├ file not found",
+		"      └ fallback: no default",
+		"        └ no default",
+	}, "\n")+"\n", pterm.RemoveColorFromString(buf.String()))
+}
+
+func TestLogger_JoinedErrors(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithTime(false)
+
+	logger.Error("failed", logger.Args(
+		"joined", errors.Join(errors.New("first"), errors.New("second")),
+		"wrapped", fmt.Errorf("both: %w, %w", errors.New("first"), errors.New("second")),
+	))
+
+	testza.AssertEqual(t, strings.Join([]string{
+		"ERROR failed",
+		"    ├ joined: ",
+		"    │ ├ first",
+		"    │ └ second",
+		"    └ wrapped: both: first, second",
+		"      ├ first",
+		"      └ second",
+	}, "\n")+"\n", pterm.RemoveColorFromString(buf.String()))
+
+	buf.Reset()
+	logger.WithFormatter(pterm.LogFormatterJSON).Error("failed", logger.Args("joined", errors.Join(errors.New("first"), errors.New("second"))))
+
+	var m map[string]any
+	testza.AssertNoError(t, json.Unmarshal(buf.Bytes(), &m))
+	testza.AssertEqual(t, []any{"first", "second"}, m["joined"])
+}
+
+func TestLogger_StructuredValues(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithTime(false)
+
+	logger.Info("user", logger.Args(
+		"user", loggerTestUser{Name: "marvin", Roles: []string{"admin", "dev"}, age: 3},
+		"meta", map[string]int{"b": 2, "a": 1},
+		"took", 1500*time.Millisecond,
+	))
+
+	testza.AssertEqual(t, strings.Join([]string{
+		"INFO  user",
+		"    ├ user: ",
+		"    │ ├ Name: marvin",
+		"    │ └ Roles: ",
+		"    │   ├ 0: admin",
+		"    │   └ 1: dev",
+		"    ├ meta: ",
+		"    │ ├ a: 1",
+		"    │ └ b: 2",
+		"    └ took: 1.5s",
+	}, "\n")+"\n", pterm.RemoveColorFromString(buf.String()))
+}
+
+func TestLogger_JSONErrorsAndDurations(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithFormatter(pterm.LogFormatterJSON)
+
+	logger.Info("message", logger.Args(
+		"plain", errors.New("plain error"),
+		"wrapped", fmt.Errorf("outer: %w", errors.New("inner")),
+		"took", 1500*time.Millisecond,
+	))
+
+	var m map[string]any
+	testza.AssertNoError(t, json.Unmarshal(buf.Bytes(), &m))
+	testza.AssertEqual(t, "plain error", m["plain"])
+	testza.AssertEqual(t, map[string]any{"message": "outer: inner", "causes": []any{"inner"}}, m["wrapped"])
+	testza.AssertEqual(t, "1.5s", m["took"])
+}
+
+func TestLogger_StackTrace(t *testing.T) {
+	for _, formatter := range []pterm.LogFormatter{pterm.LogFormatterColorful, pterm.LogFormatterJSON, pterm.LogFormatterLogfmt, pterm.LogFormatterPlain} {
+		var buf bytes.Buffer
+		logger := pterm.DefaultLogger.WithWriter(&buf).WithFormatter(formatter).WithStackTrace()
+
+		logger.Warn("warning")
+		testza.AssertNotContains(t, buf.String(), "TestLogger_StackTrace")
+
+		logger.Error("error")
+		testza.AssertContains(t, buf.String(), "pterm_test.TestLogger_StackTrace")
+		testza.AssertNotContains(t, buf.String(), "pterm.Logger.Error")
+	}
+}
+
+func TestLogger_StackTrace_SlogHandler(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithFormatter(pterm.LogFormatterPlain).WithStackTrace()
+
+	slog.New(pterm.NewSlogHandler(logger)).Error("error")
+
+	testza.AssertContains(t, buf.String(), "\n\t")
+	testza.AssertContains(t, buf.String(), "pterm_test.TestLogger_StackTrace_SlogHandler")
+	testza.AssertNotContains(t, buf.String(), "log/slog")
+}
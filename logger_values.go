@@ -0,0 +1,245 @@
+package pterm
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxLoggerValueDepth limits how deep nested values and error chains are rendered.
+const maxLoggerValueDepth = 8
+
+// loggerStackTrace is the stack trace of a log message, with one frame per entry.
+type loggerStackTrace []string
+
+// loggerValueNode is a node of the tree, which shows a complex argument value.
+type loggerValueNode struct {
+	// key is not shown, if it is empty.
+	key      string
+	value    string
+	children []loggerValueNode
+}
+
+// describeLoggerValue returns the text of an argument value and the nodes, which are shown below it.
+// Errors show the errors they wrap, maps, structs and slices show their elements and stack traces show their frames.
+// All other values are formatted with Sprint.
+func describeLoggerValue(value any, depth int) (string, []loggerValueNode) {
+	switch v := value.(type) {
+	case nil:
+		return "<nil>", nil
+	case error:
+		if isJoinedError(v) {
+			return "", errorCauses(v, depth)
+		}
+		return v.Error(), errorCauses(v, depth)
+	case loggerStackTrace:
+		nodes := make([]loggerValueNode, len(v))
+		for i, frame := range v {
+			nodes[i] = loggerValueNode{value: frame}
+		}
+		return "", nodes
+	case time.Time, time.Duration, fmt.Stringer:
+		return Sprint(v), nil
+	}
+
+	if depth >= maxLoggerValueDepth {
+		return Sprint(value), nil
+	}
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return "<nil>", nil
+		}
+		rv = rv.Elem()
+	}
+
+	var nodes []loggerValueNode
+	add := func(key string, value any) {
+		text, children := describeLoggerValue(value, depth+1)
+		nodes = append(nodes, loggerValueNode{key: key, value: text, children: children})
+	}
+
+	switch rv.Kind() {
+	case reflect.Map:
+		keys := rv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return Sprint(keys[i].Interface()) < Sprint(keys[j].Interface())
+		})
+		for _, key := range keys {
+			add(Sprint(key.Interface()), rv.MapIndex(key).Interface())
+		}
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			if field := rv.Type().Field(i); field.IsExported() {
+				add(field.Name, rv.Field(i).Interface())
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		for i := 0; i < rv.Len(); i++ {
+			add(strconv.Itoa(i), rv.Index(i).Interface())
+		}
+	}
+
+	if len(nodes) == 0 {
+		return Sprint(rv.Interface()), nil
+	}
+	return "", nodes
+}
+
+// errorCauses returns the errors, which are wrapped by the error.
+// A chain of errors, which each wrap a single error, is returned as a flat list.
+// Errors, which wrap multiple errors, have their wrapped errors as children.
+// Joined errors (like errors.Join) are replaced by their wrapped errors, as their message only repeats them.
+func errorCauses(err error, depth int) []loggerValueNode {
+	if depth >= maxLoggerValueDepth {
+		return nil
+	}
+
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		var nodes []loggerValueNode
+		for _, cause := range e.Unwrap() {
+			switch {
+			case cause == nil:
+				continue
+			case isJoinedError(cause):
+				nodes = append(nodes, errorCauses(cause, depth+1)...)
+			default:
+				nodes = append(nodes, loggerValueNode{value: cause.Error(), children: errorCauses(cause, depth+1)})
+			}
+		}
+		return nodes
+	case interface{ Unwrap() error }:
+		cause := e.Unwrap()
+		if cause == nil {
+			return nil
+		}
+		if isJoinedError(cause) {
+			return errorCauses(cause, depth+1)
+		}
+		node := loggerValueNode{value: cause.Error()}
+		if _, ok := cause.(interface{ Unwrap() []error }); ok {
+			node.children = errorCauses(cause, depth+1)
+			return []loggerValueNode{node}
+		}
+		return append([]loggerValueNode{node}, errorCauses(cause, depth+1)...)
+	}
+
+	return nil
+}
+
+// isJoinedError returns true if the error wraps multiple errors and its message is only made of their messages,
+// separated by newlines, like the errors returned by errors.Join.
+func isJoinedError(err error) bool {
+	e, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return false
+	}
+
+	var messages []string
+	for _, cause := range e.Unwrap() {
+		if cause != nil {
+			messages = append(messages, cause.Error())
+		}
+	}
+	return len(messages) > 0 && err.Error() == strings.Join(messages, "\n")
+}
+
+// renderLoggerValueTree renders the nodes as the lines of a tree.
+func renderLoggerValueTree(nodes []loggerValueNode, keyStyle Style) []string {
+	var lines []string
+
+	for i, node := range nodes {
+		pipe, indent := "├", "│"
+		if i == len(nodes)-1 {
+			pipe, indent = "└", " "
+		}
+
+		line := pipe + " "
+		if node.key != "" {
+			line += keyStyle.Sprintf("%s: ", node.key)
+		}
+		// values with multiple lines, like joined errors, continue below the first line
+		for j, valueLine := range strings.Split(node.value, "\n") {
+			if j == 0 {
+				lines = append(lines, line+valueLine)
+			} else {
+				lines = append(lines, indent+" "+valueLine)
+			}
+		}
+
+		for _, child := range renderLoggerValueTree(node.children, keyStyle) {
+			lines = append(lines, indent+" "+child)
+		}
+	}
+
+	return lines
+}
+
+// loggerValueToJSON converts values, which are not encoded properly by encoding/json.
+// Errors are encoded as their message, or as an object with their message and causes, if they wrap other errors.
+// Joined errors are encoded as the list of their causes.
+// Durations are encoded as their string representation.
+func loggerValueToJSON(value any) any {
+	switch v := value.(type) {
+	case error:
+		causes := errorCauses(v, 0)
+		if len(causes) == 0 {
+			return v.Error()
+		}
+		if isJoinedError(v) {
+			return loggerValueNodesToJSON(causes)
+		}
+		return loggerJSONObject{{Key: "message", Value: v.Error()}, {Key: "causes", Value: loggerValueNodesToJSON(causes)}}
+	case time.Duration:
+		return v.String()
+	}
+	return value
+}
+
+// loggerValueNodesToJSON converts the causes of an error to JSON values.
+func loggerValueNodesToJSON(nodes []loggerValueNode) []any {
+	result := make([]any, len(nodes))
+	for i, node := range nodes {
+		if len(node.children) == 0 {
+			result[i] = node.value
+			continue
+		}
+		result[i] = loggerJSONObject{{Key: "message", Value: node.value}, {Key: "causes", Value: loggerValueNodesToJSON(node.children)}}
+	}
+	return result
+}
+
+// getStackTrace returns the stack trace of the caller of the logger.
+func (l Logger) getStackTrace() loggerStackTrace {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(l.CallerOffset+4, pcs)
+	return stackTraceFromPCs(pcs[:n])
+}
+
+// stackTraceFromPCs returns the stack trace of the program counters.
+// Frames of the Go runtime are left out.
+func stackTraceFromPCs(pcs []uintptr) loggerStackTrace {
+	var stack loggerStackTrace
+
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" && !strings.HasPrefix(frame.Function, "runtime.") {
+			stack = append(stack, Sprintf("%s:%d %s", trimCallerPath(frame.File), frame.Line, frame.Function))
+		}
+		if !more {
+			break
+		}
+	}
+
+	return stack
+}
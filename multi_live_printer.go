@@ -1,12 +1,13 @@
 package pterm
 
 import (
-	"atomicgo.dev/schedule"
-	"bytes"
 	"io"
 	"os"
 	"strings"
+	"sync"
 	"time"
+
+	"atomicgo.dev/schedule"
 )
 
 var DefaultMultiPrinter = MultiPrinter{
@@ -14,8 +15,7 @@ var DefaultMultiPrinter = MultiPrinter{
 	Writer:      os.Stdout,
 	UpdateDelay: time.Millisecond * 200,
 
-	buffers: []*bytes.Buffer{},
-	area:    DefaultArea,
+	area: DefaultArea,
 }
 
 type MultiPrinter struct {
@@ -23,17 +23,68 @@ type MultiPrinter struct {
 	Writer      io.Writer
 	UpdateDelay time.Duration
 
-	printers []LivePrinter
-	buffers  []*bytes.Buffer
-	area     AreaPrinter
+	mu         *sync.Mutex
+	printers   []LivePrinter
+	writers    []*liveWriter
+	scrollback []string
+	area       AreaPrinter
+}
+
+// liveWriter is a thread-safe writer, which keeps the content written by a live printer since its last override.
+// Live printers override their line with a carriage return, so only the content after the last
+// carriage return, which is not blank, is kept.
+type liveWriter struct {
+	mu      sync.Mutex
+	content string
+}
+
+// Write appends p to the content of the liveWriter and drops all overridden content.
+func (w *liveWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.content += string(p)
+
+	parts := strings.Split(w.content, "\r")
+	if i := lastNonBlank(parts); i > 0 {
+		w.content = strings.Join(parts[i:], "\r")
+	}
+
+	return len(p), nil
+}
+
+// String returns the content after the last carriage return, which is not blank.
+func (w *liveWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	parts := strings.Split(w.content, "\r")
+	if i := lastNonBlank(parts); i >= 0 {
+		return strings.Trim(parts[i], "\n")
+	}
+	return ""
 }
 
-// SetWriter sets the writer for the AreaPrinter.
+// lastNonBlank returns the index of the last part, which contains more than whitespace, or -1 if there is none.
+func lastNonBlank(parts []string) int {
+	for i := len(parts) - 1; i >= 0; i-- {
+		if strings.TrimSpace(RemoveColorFromString(parts[i])) != "" {
+			return i
+		}
+	}
+	return -1
+}
+
+// SetWriter sets the writer, which the MultiPrinter renders to.
 func (p *MultiPrinter) SetWriter(writer io.Writer) {
+	unlock := p.lock()
+	defer unlock()
+
 	p.Writer = writer
+	p.area.SetWriter(writer)
 }
 
-// WithWriter returns a fork of the MultiPrinter with a new writer.
+// WithWriter returns a fork of the MultiPrinter with a new writer, which it renders to.
 func (p MultiPrinter) WithWriter(writer io.Writer) *MultiPrinter {
 	p.Writer = writer
 	return &p
@@ -45,47 +96,134 @@ func (p MultiPrinter) WithUpdateDelay(delay time.Duration) *MultiPrinter {
 	return &p
 }
 
+// NewWriter returns a new thread-safe writer for a live printer.
+// Every writer is rendered as one entry of the MultiPrinter, in the order they were created.
 func (p *MultiPrinter) NewWriter() io.Writer {
-	buf := bytes.NewBufferString("")
-	p.buffers = append(p.buffers, buf)
-	return buf
+	unlock := p.lock()
+	defer unlock()
+
+	w := &liveWriter{}
+	p.writers = append(p.writers, w)
+	return w
 }
 
-// getString returns all buffers appended and separated by a newline.
-func (p *MultiPrinter) getString() string {
-	var buffer bytes.Buffer
-	for _, b := range p.buffers {
-		s := b.String()
-		s = strings.Trim(s, "\n")
-
-		parts := strings.Split(s, "\r") // only get the last override
-		s = parts[len(parts)-1]
-
-		// check if s is empty, if so get one part before, repeat until not empty
-		for s == "" {
-			parts = parts[:len(parts)-1]
-			s = parts[len(parts)-1]
+// Remove removes the entry of a writer, which was created by NewWriter, from the MultiPrinter.
+// Unknown writers are ignored.
+func (p *MultiPrinter) Remove(writer io.Writer) {
+	unlock := p.lock()
+	defer unlock()
+
+	p.remove(writer)
+}
+
+// Collapse removes the entry of a writer, which was created by NewWriter, from the MultiPrinter
+// and prints its last content permanently above the live area.
+// This is useful to keep the result of finished printers, while the others are still running.
+// Unknown writers are ignored.
+func (p *MultiPrinter) Collapse(writer io.Writer) {
+	unlock := p.lock()
+	defer unlock()
+
+	if w := p.remove(writer); w != nil {
+		p.scrollback = append(p.scrollback, w.String())
+	}
+}
+
+// Move moves the entry of a writer, which was created by NewWriter, to the given index.
+// The index is clamped to the range of the entries. Unknown writers are ignored.
+func (p *MultiPrinter) Move(writer io.Writer, index int) {
+	unlock := p.lock()
+	defer unlock()
+
+	w := p.remove(writer)
+	if w == nil {
+		return
+	}
+
+	index = min(max(index, 0), len(p.writers))
+	p.writers = append(p.writers[:index], append([]*liveWriter{w}, p.writers[index:]...)...)
+}
+
+// remove removes a writer from the entries and returns it, or nil if the writer is unknown.
+// It has to be called while the MultiPrinter is locked.
+func (p *MultiPrinter) remove(writer io.Writer) *liveWriter {
+	for i, w := range p.writers {
+		if io.Writer(w) == writer {
+			p.writers = append(p.writers[:i:i], p.writers[i+1:]...)
+			return w
 		}
+	}
+	return nil
+}
+
+// multiPrinterInitMu guards the creation of the mutex of a MultiPrinter.
+var multiPrinterInitMu sync.Mutex
+
+// lock locks the MultiPrinter and returns a function to unlock it.
+// The mutex is created on first use, as a MultiPrinter is usually forked from DefaultMultiPrinter.
+// Its creation is guarded by multiPrinterInitMu, so that goroutines, which use a new MultiPrinter at once, share the same mutex.
+func (p *MultiPrinter) lock() func() {
+	multiPrinterInitMu.Lock()
+	if p.mu == nil {
+		p.mu = &sync.Mutex{}
+	}
+	mu := p.mu
+	multiPrinterInitMu.Unlock()
+
+	mu.Lock()
+	return mu.Unlock
+}
+
+// Srender returns the current content of the live area of the MultiPrinter.
+func (p *MultiPrinter) Srender() (string, error) {
+	unlock := p.lock()
+	defer unlock()
 
-		s = strings.Trim(s, "\n\r")
-		buffer.WriteString(s)
+	return p.getString(), nil
+}
+
+// getString returns all entries appended and separated by a newline.
+func (p *MultiPrinter) getString() string {
+	var buffer strings.Builder
+	for _, w := range p.writers {
+		buffer.WriteString(w.String())
 		buffer.WriteString("\n")
 	}
 	return buffer.String()
 }
 
+// update renders the entries of the MultiPrinter.
+// Collapsed entries are printed above the live area.
+// It has to be called while the MultiPrinter is locked.
+func (p *MultiPrinter) update() {
+	p.area.Update(p.getString())
+
+	if len(p.scrollback) != 0 {
+		Fprint(p.Writer, strings.Join(p.scrollback, "\n")+"\n")
+		p.scrollback = nil
+	}
+}
+
 func (p *MultiPrinter) Start() (*MultiPrinter, error) {
+	unlock := p.lock()
 	p.IsActive = true
+	p.area.Writer = p.Writer
+	_, _ = p.area.Start(p.getString())
+	unlock()
+
 	for _, printer := range p.printers {
 		printer.GenericStart()
 	}
 
 	schedule.Every(p.UpdateDelay, func() bool {
+		unlock := p.lock()
+		defer unlock()
+
 		if !p.IsActive {
 			return false
 		}
 
-		p.area.Update(p.getString())
+		p.update()
 
 		return true
 	})
@@ -94,12 +232,19 @@ func (p *MultiPrinter) Start() (*MultiPrinter, error) {
 }
 
 func (p *MultiPrinter) Stop() (*MultiPrinter, error) {
+	unlock := p.lock()
 	p.IsActive = false
+	unlock()
+
 	for _, printer := range p.printers {
 		printer.GenericStop()
 	}
 	time.Sleep(time.Millisecond * 20)
-	p.area.Update(p.getString())
+
+	unlock = p.lock()
+	defer unlock()
+
+	p.update()
 	p.area.Stop()
 
 	return p, nil
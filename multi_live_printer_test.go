@@ -0,0 +1,127 @@
+package pterm_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func TestMultiPrinter_RendersWriters(t *testing.T) {
+	multi := pterm.DefaultMultiPrinter
+	first := multi.NewWriter()
+	second := multi.NewWriter()
+	third := multi.NewWriter()
+
+	pterm.Fprinto(first, "first 1")
+	pterm.Fprinto(first, "first 2")
+	pterm.Fprintln(second, "second")
+	pterm.Fprinto(third, "third")
+	pterm.Fprinto(third, "   ")
+
+	s, err := multi.Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "first 2\nsecond\nthird\n", s)
+}
+
+func TestMultiPrinter_Remove(t *testing.T) {
+	multi := pterm.DefaultMultiPrinter
+	first := multi.NewWriter()
+	second := multi.NewWriter()
+
+	pterm.Fprinto(first, "first")
+	pterm.Fprinto(second, "second")
+	multi.Remove(first)
+	multi.Remove(&strings.Builder{})
+
+	s, _ := multi.Srender()
+	testza.AssertEqual(t, "second\n", s)
+}
+
+func TestMultiPrinter_Collapse(t *testing.T) {
+	var buf bytes.Buffer
+	multi := pterm.DefaultMultiPrinter.WithWriter(&buf)
+	first := multi.NewWriter()
+	second := multi.NewWriter()
+	multi.Start()
+
+	pterm.Fprinto(first, "first done")
+	pterm.Fprinto(second, "second")
+	multi.Collapse(first)
+	s, _ := multi.Srender()
+	multi.Stop()
+
+	testza.AssertEqual(t, "second\n", s)
+	testza.AssertContains(t, buf.String(), "first done\n")
+}
+
+func TestMultiPrinter_Move(t *testing.T) {
+	multi := pterm.DefaultMultiPrinter
+	first := multi.NewWriter()
+	second := multi.NewWriter()
+	third := multi.NewWriter()
+
+	pterm.Fprinto(first, "first")
+	pterm.Fprinto(second, "second")
+	pterm.Fprinto(third, "third")
+	multi.Move(third, 0)
+	multi.Move(first, 10)
+
+	s, _ := multi.Srender()
+	testza.AssertEqual(t, "third\nsecond\nfirst\n", s)
+}
+
+func TestMultiPrinter_ConcurrentWrites(t *testing.T) {
+	originalStdout := os.Stdout
+	os.Stdout = os.NewFile(0, os.DevNull) // Set os.Stdout to DevNull to hide output from cursor.Area
+	defer func() {
+		os.Stdout = originalStdout // Restore original os.Stdout
+	}()
+
+	multi := pterm.DefaultMultiPrinter.WithUpdateDelay(time.Millisecond)
+	multi.Start()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		w := multi.NewWriter()
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j <= 100; j++ {
+				pterm.Fprinto(w, fmt.Sprintf("writer %d: %d", i, j))
+			}
+		}(i)
+	}
+	wg.Wait()
+	multi.Stop()
+
+	s, _ := multi.Srender()
+	testza.AssertEqual(t, "writer 0: 100\nwriter 1: 100\nwriter 2: 100\nwriter 3: 100\nwriter 4: 100\n", s)
+}
+
+func TestMultiPrinter_ConcurrentWritersOnNewPrinter(t *testing.T) {
+	multi := &pterm.MultiPrinter{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w := multi.NewWriter()
+			pterm.Fprinto(w, "writer")
+			multi.Remove(w)
+		}()
+	}
+	wg.Wait()
+
+	s, err := multi.Srender()
+	testza.AssertNoError(t, err)
+	testza.AssertZero(t, s)
+}
@@ -3,14 +3,19 @@ package pterm
 import (
 	"fmt"
 	"io"
+	"os"
 	"strings"
 
 	"github.com/gookit/color"
 )
 
+// defaultOutput is the output of printers without a Writer.
+var defaultOutput io.Writer = os.Stdout
+
 // SetDefaultOutput sets the default output of pterm.
 func SetDefaultOutput(w io.Writer) {
 	color.SetOutput(w)
+	defaultOutput = w
 }
 
 // Sprint formats using the default formats for its operands and returns the resulting string.
@@ -102,41 +107,14 @@ func Fprint(writer io.Writer, a ...interface{}) {
 		return
 	}
 
-	var ret string
-	var printed bool
-
-	for _, bar := range ActiveProgressBarPrinters {
-		if bar.IsActive && bar.Writer == writer {
-			ret += sClearLine()
-			ret += Sprinto(a...)
-			printed = true
-		}
-	}
-
-	for _, spinner := range activeSpinnerPrinters {
-		if spinner.IsActive && spinner.Writer == writer {
-			ret += sClearLine()
-			ret += Sprinto(a...)
-			printed = true
-		}
-	}
-
-	if !printed {
-		ret = color.Sprint(Sprint(a...))
-	}
-
-	if writer != nil {
-		color.Fprint(writer, Sprint(ret))
-	} else {
-		color.Print(Sprint(ret))
-	}
-
-	// Refresh all progressbars in case they were overwritten previously. Reference: #302
-	for _, bar := range ActiveProgressBarPrinters {
-		if bar.IsActive {
-			bar.UpdateTitle(bar.Title)
+	// the output is inserted above active live printers, which render to the same writer. Reference: #302
+	printAboveLive(writer, Sprint(a...), func(s string) {
+		if writer != nil {
+			color.Fprint(writer, s)
+		} else {
+			color.Print(s)
 		}
-	}
+	})
 }
 
 // Fprintln formats using the default formats for its operands and writes to w.
@@ -181,7 +159,3 @@ func RemoveColorFromString(a ...interface{}) string {
 func fClearLine(writer io.Writer) {
 	Fprinto(writer, strings.Repeat(" ", GetTerminalWidth()))
 }
-
-func sClearLine() string {
-	return Sprinto(strings.Repeat(" ", GetTerminalWidth()))
-}
@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"strconv"
 	"strings"
+	"sync"
+	"text/template"
 	"time"
 
 	"github.com/gookit/color"
@@ -18,6 +21,9 @@ import (
 // Generally, there should only be one active ProgressbarPrinter at a time.
 var ActiveProgressBarPrinters []*ProgressbarPrinter
 
+// activeProgressBarPrintersMu guards ActiveProgressBarPrinters.
+var activeProgressBarPrintersMu sync.Mutex
+
 // DefaultProgressbar is the default ProgressbarPrinter.
 var DefaultProgressbar = ProgressbarPrinter{
 	Total:                     100,
@@ -32,6 +38,33 @@ var DefaultProgressbar = ProgressbarPrinter{
 	ShowElapsedTime:           true,
 	BarFiller:                 Gray("█"),
 	MaxWidth:                  80,
+	RateWindow:                5 * time.Second,
+	SuccessPrinter:            &Success,
+	FailPrinter:               &Error,
+	WarningPrinter:            &Warning,
+}
+
+// defaultProgressbarRefreshInterval is the RefreshInterval, which is used if none is set.
+const defaultProgressbarRefreshInterval = 100 * time.Millisecond
+
+// indeterminateFrameDuration is the duration of one step of the animation of an indeterminate ProgressbarPrinter.
+const indeterminateFrameDuration = 100 * time.Millisecond
+
+// ProgressbarUnit defines how the current and total value of a ProgressbarPrinter are displayed.
+type ProgressbarUnit int
+
+const (
+	// ProgressbarUnitCount displays the values as plain numbers.
+	ProgressbarUnitCount ProgressbarUnit = iota
+	// ProgressbarUnitBytes displays the values as human-readable byte sizes, like "1.5 MiB".
+	ProgressbarUnitBytes
+)
+
+// progressbarSample is the value of a ProgressbarPrinter at a point in time.
+// The samples are used to calculate the rate of the ProgressbarPrinter.
+type progressbarSample struct {
+	at      time.Time
+	current int
 }
 
 // ProgressbarPrinter shows a progress animation in the terminal.
@@ -44,20 +77,37 @@ type ProgressbarPrinter struct {
 	ElapsedTimeRoundingFactor time.Duration
 	BarFiller                 string
 	MaxWidth                  int
-
-	ShowElapsedTime bool
-	ShowCount       bool
-	ShowTitle       bool
-	ShowPercentage  bool
-	RemoveWhenDone  bool
+	Unit                      ProgressbarUnit
+	RateWindow                time.Duration
+	RefreshInterval           time.Duration
+	Template                  string
+
+	ShowElapsedTime   bool
+	ShowCount         bool
+	ShowTitle         bool
+	ShowPercentage    bool
+	ShowRate          bool
+	ShowRemainingTime bool
+	RemoveWhenDone    bool
 
 	TitleStyle *Style
 	BarStyle   *Style
 
+	SuccessPrinter TextPrinter
+	FailPrinter    TextPrinter
+	WarningPrinter TextPrinter
+
 	IsActive bool
 
-	startedAt    time.Time
-	rerenderTask *schedule.Task
+	startedAt      time.Time
+	rerenderTask   *schedule.Task
+	samples        []progressbarSample
+	mu             *sync.Mutex
+	lastRenderedAt time.Time
+	isDirty        bool
+	liveOutput     string
+	template       *template.Template
+	status         string
 
 	Writer io.Writer
 }
@@ -77,6 +127,7 @@ func (p ProgressbarPrinter) WithMaxWidth(maxWidth int) *ProgressbarPrinter {
 }
 
 // WithTotal sets the total value of the ProgressbarPrinter.
+// If the total is zero, or below, the ProgressbarPrinter is indeterminate.
 func (p ProgressbarPrinter) WithTotal(total int) *ProgressbarPrinter {
 	p.Total = total
 	return &p
@@ -130,6 +181,70 @@ func (p ProgressbarPrinter) WithShowPercentage(b ...bool) *ProgressbarPrinter {
 	return &p
 }
 
+// WithShowRate sets if the rate, at which the ProgressbarPrinter progresses, should be displayed.
+// The rate is averaged over the RateWindow.
+func (p ProgressbarPrinter) WithShowRate(b ...bool) *ProgressbarPrinter {
+	p.ShowRate = internal.WithBoolean(b)
+	return &p
+}
+
+// WithShowRemainingTime sets if the estimated remaining time should be displayed in the ProgressbarPrinter.
+func (p ProgressbarPrinter) WithShowRemainingTime(b ...bool) *ProgressbarPrinter {
+	p.ShowRemainingTime = internal.WithBoolean(b)
+	return &p
+}
+
+// WithUnit sets the unit in which the current and total value of the ProgressbarPrinter are displayed.
+func (p ProgressbarPrinter) WithUnit(unit ProgressbarUnit) *ProgressbarPrinter {
+	p.Unit = unit
+	return &p
+}
+
+// WithRateWindow sets the duration over which the rate of the ProgressbarPrinter is averaged.
+// If the duration is zero, or below, the rate is averaged over the whole runtime of the ProgressbarPrinter.
+func (p ProgressbarPrinter) WithRateWindow(window time.Duration) *ProgressbarPrinter {
+	p.RateWindow = window
+	return &p
+}
+
+// WithRefreshInterval sets the minimum duration between two renders of the ProgressbarPrinter.
+// Updates in between are combined into a single render.
+// If the duration is zero, or below, 100 milliseconds are used.
+func (p ProgressbarPrinter) WithRefreshInterval(interval time.Duration) *ProgressbarPrinter {
+	p.RefreshInterval = interval
+	return &p
+}
+
+// WithTemplate sets a text/template, which replaces the default layout of the ProgressbarPrinter.
+// The fields of ProgressbarTemplateData can be used in the template, and the bar fills the remaining width of the line.
+// The Show* options have no effect, if a template is set.
+//
+// Example:
+//
+//	pterm.DefaultProgressbar.WithTemplate("{{.Title}} {{.Bar}} {{.Percent}}% (ETA {{.ETA}})")
+func (p ProgressbarPrinter) WithTemplate(template string) *ProgressbarPrinter {
+	p.Template = template
+	return &p
+}
+
+// WithSuccessPrinter sets the TextPrinter, which is used by Success.
+func (p ProgressbarPrinter) WithSuccessPrinter(printer TextPrinter) *ProgressbarPrinter {
+	p.SuccessPrinter = printer
+	return &p
+}
+
+// WithFailPrinter sets the TextPrinter, which is used by Fail.
+func (p ProgressbarPrinter) WithFailPrinter(printer TextPrinter) *ProgressbarPrinter {
+	p.FailPrinter = printer
+	return &p
+}
+
+// WithWarningPrinter sets the TextPrinter, which is used by Warning.
+func (p ProgressbarPrinter) WithWarningPrinter(printer TextPrinter) *ProgressbarPrinter {
+	p.WarningPrinter = printer
+	return &p
+}
+
 // WithTitleStyle sets the style of the title.
 func (p ProgressbarPrinter) WithTitleStyle(style *Style) *ProgressbarPrinter {
 	p.TitleStyle = style
@@ -166,6 +281,7 @@ func (p *ProgressbarPrinter) SetWriter(writer io.Writer) {
 }
 
 // Increment current value by one.
+// It is safe to call Increment from multiple goroutines.
 func (p *ProgressbarPrinter) Increment() *ProgressbarPrinter {
 	p.Add(1)
 	return p
@@ -173,17 +289,98 @@ func (p *ProgressbarPrinter) Increment() *ProgressbarPrinter {
 
 // UpdateTitle updates the title and re-renders the progressbar
 func (p *ProgressbarPrinter) UpdateTitle(title string) *ProgressbarPrinter {
+	unlock := p.lock()
+	defer unlock()
+
 	p.Title = title
 	p.updateProgress()
 	return p
 }
 
-// This is the update logic, renders the progressbar
+// lock locks the ProgressbarPrinter and returns the function to unlock it.
+// The lock is created when the ProgressbarPrinter is started, so a ProgressbarPrinter, which was not started, is never locked.
+func (p *ProgressbarPrinter) lock() func() {
+	if p.mu == nil {
+		return func() {}
+	}
+	p.mu.Lock()
+	return p.mu.Unlock
+}
+
+// This is the update logic, renders the progressbar.
+// To limit the number of writes, the progressbar is rendered at most once per RefreshInterval.
+// Updates in between are rendered by the refresh loop.
+// It has to be called while the ProgressbarPrinter is locked.
 func (p *ProgressbarPrinter) updateProgress() *ProgressbarPrinter {
-	Fprinto(p.Writer, p.getString())
+	if !p.IsActive {
+		return p
+	}
+	if time.Since(p.lastRenderedAt) < p.refreshInterval() {
+		p.isDirty = true
+		return p
+	}
+	p.render()
 	return p
 }
 
+// render writes the progressbar to its Writer.
+// It has to be called while the ProgressbarPrinter is locked.
+func (p *ProgressbarPrinter) render() {
+	fprintLive(p.Writer, &p.liveOutput, p.getString())
+	p.lastRenderedAt = time.Now()
+	p.isDirty = false
+}
+
+// refresh renders the progressbar, if there are pending updates or the displayed times are outdated.
+func (p *ProgressbarPrinter) refresh() {
+	unlock := p.lock()
+	defer unlock()
+
+	if !p.IsActive {
+		return
+	}
+
+	isOutdated := (p.ShowElapsedTime || p.ShowRate || p.ShowRemainingTime) && time.Since(p.lastRenderedAt) >= time.Second
+	if p.isDirty || isOutdated || p.isIndeterminate() {
+		p.render()
+	}
+}
+
+func (p *ProgressbarPrinter) refreshInterval() time.Duration {
+	if p.RefreshInterval <= 0 {
+		return defaultProgressbarRefreshInterval
+	}
+	return p.RefreshInterval
+}
+
+// ProgressbarTemplateData contains the values, which can be used in the Template of a ProgressbarPrinter.
+type ProgressbarTemplateData struct {
+	// Title is the styled title, or the status message after the ProgressbarPrinter was finished with Success, Fail or Warning.
+	Title string
+	// Bar is the bar, which fills the remaining width of the line.
+	Bar string
+	// Count is the styled current and total value, like "[05/10]".
+	Count string
+	// Current is the current value, formatted in the Unit of the ProgressbarPrinter.
+	Current string
+	// Total is the total value, formatted in the Unit of the ProgressbarPrinter. It is empty, if the ProgressbarPrinter is indeterminate.
+	Total string
+	// Percent is the completed percentage.
+	Percent int
+	// Percentage is the styled completed percentage, like " 42%".
+	Percentage string
+	// ElapsedTime is the elapsed time, rounded by the ElapsedTimeRoundingFactor.
+	ElapsedTime string
+	// Rate is the rate, at which the ProgressbarPrinter progresses, like "1.5 MiB/s".
+	Rate string
+	// ETA is the estimated remaining time, or "?" if it can't be estimated yet.
+	ETA string
+}
+
+// progressbarTemplateBar is a placeholder for the bar, while the Template is executed.
+// The bar is inserted afterward, when the remaining width of the line is known.
+const progressbarTemplateBar = "\x00bar\x00"
+
 func (p *ProgressbarPrinter) getString() string {
 	if !p.IsActive {
 		return ""
@@ -194,12 +391,6 @@ func (p *ProgressbarPrinter) getString() string {
 	if p.BarStyle == nil {
 		p.BarStyle = NewStyle()
 	}
-	if p.Total == 0 {
-		return ""
-	}
-
-	var before string
-	var after string
 	var width int
 
 	if p.MaxWidth <= 0 {
@@ -210,28 +401,100 @@ func (p *ProgressbarPrinter) getString() string {
 		width = p.MaxWidth
 	}
 
+	data := p.templateData()
+
+	if p.template != nil {
+		data.Bar = progressbarTemplateBar
+		var buf strings.Builder
+		if err := p.template.Execute(&buf, data); err != nil {
+			return err.Error()
+		}
+		line := buf.String()
+		barMaxLength := width - len(RemoveColorFromString(strings.Replace(line, progressbarTemplateBar, "", 1))) - 1
+		return strings.Replace(line, progressbarTemplateBar, p.renderBar(barMaxLength), 1)
+	}
+
+	var before string
+	var after string
+
 	if p.ShowTitle {
-		before += p.TitleStyle.Sprint(p.Title) + " "
+		before += data.Title + " "
 	}
 	if p.ShowCount {
-		padding := 1 + int(math.Log10(float64(p.Total)))
-		before += Gray("[") + LightWhite(fmt.Sprintf("%0*d", padding, p.Current)) + Gray("/") + LightWhite(p.Total) + Gray("]") + " "
+		before += data.Count + " "
 	}
 
 	after += " "
 
-	if p.ShowPercentage {
-		currentPercentage := int(internal.PercentageRound(float64(int64(p.Total)), float64(int64(p.Current))))
-		decoratorCurrentPercentage := color.RGB(NewRGB(255, 0, 0).Fade(0, float32(p.Total), float32(p.Current), NewRGB(0, 255, 0)).GetValues()).
-			Sprintf("%3d%%", currentPercentage)
-		after += decoratorCurrentPercentage + " "
+	if p.ShowPercentage && !p.isIndeterminate() {
+		after += data.Percentage + " "
 	}
+	var infos []string
 	if p.ShowElapsedTime {
-		after += "| " + p.parseElapsedTime()
+		infos = append(infos, data.ElapsedTime)
+	}
+	if p.ShowRate {
+		infos = append(infos, data.Rate)
+	}
+	if p.ShowRemainingTime && !p.isIndeterminate() {
+		infos = append(infos, "ETA "+data.ETA)
+	}
+	if len(infos) > 0 {
+		after += "| " + strings.Join(infos, " | ")
 	}
 
 	barMaxLength := width - len(RemoveColorFromString(before)) - len(RemoveColorFromString(after)) - 1
 
+	return before + p.renderBar(barMaxLength) + after
+}
+
+// templateData returns the values, which are shown in the line of the ProgressbarPrinter.
+func (p *ProgressbarPrinter) templateData() ProgressbarTemplateData {
+	data := ProgressbarTemplateData{
+		Title:       p.TitleStyle.Sprint(p.Title),
+		Current:     p.formatValue(p.Current),
+		ElapsedTime: p.parseElapsedTime(),
+		Rate:        p.parseRate(),
+		ETA:         p.parseRemainingTime(),
+	}
+	if p.status != "" {
+		data.Title = p.status
+	}
+
+	if p.isIndeterminate() {
+		data.Count = Gray("[") + LightWhite(data.Current) + Gray("]")
+		return data
+	}
+
+	data.Total = p.formatValue(p.Total)
+	if p.Unit == ProgressbarUnitBytes {
+		data.Count = Gray("[") + LightWhite(data.Current) + Gray("/") + LightWhite(data.Total) + Gray("]")
+	} else {
+		padding := 1 + int(math.Log10(float64(p.Total)))
+		data.Count = Gray("[") + LightWhite(fmt.Sprintf("%0*d", padding, p.Current)) + Gray("/") + LightWhite(p.Total) + Gray("]")
+	}
+
+	data.Percent = int(internal.PercentageRound(float64(int64(p.Total)), float64(int64(p.Current))))
+	data.Percentage = color.RGB(NewRGB(255, 0, 0).Fade(0, float32(p.Total), float32(p.Current), NewRGB(0, 255, 0)).GetValues()).
+		Sprintf("%3d%%", data.Percent)
+
+	return data
+}
+
+// formatValue formats the current or total value in the Unit of the ProgressbarPrinter.
+func (p *ProgressbarPrinter) formatValue(value int) string {
+	if p.Unit == ProgressbarUnitBytes {
+		return internal.FormatBytes(int64(value))
+	}
+	return strconv.Itoa(value)
+}
+
+// renderBar renders the bar with the given length.
+func (p *ProgressbarPrinter) renderBar(barMaxLength int) string {
+	if p.isIndeterminate() {
+		return p.indeterminateBar(barMaxLength)
+	}
+
 	barCurrentLength := (p.Current * barMaxLength) / p.Total
 	var barFiller string
 	if barMaxLength-barCurrentLength > 0 {
@@ -243,62 +506,148 @@ func (p *ProgressbarPrinter) getString() string {
 		bar = p.BarStyle.Sprint(strings.Repeat(p.BarCharacter, barCurrentLength)+p.LastCharacter) + bar
 	}
 
-	return before + bar + after
+	return bar
 }
 
-// Add to current value.
-func (p *ProgressbarPrinter) Add(count int) *ProgressbarPrinter {
-	if p.Total == 0 {
-		return nil
+// indeterminateBar renders a block, which bounces back and forth, as the total of the ProgressbarPrinter is unknown.
+func (p *ProgressbarPrinter) indeterminateBar(barMaxLength int) string {
+	if barMaxLength <= 0 {
+		return ""
 	}
 
+	blockLength := min(max(barMaxLength/5, 3), barMaxLength)
+	span := barMaxLength - blockLength
+	var position int
+	if span > 0 {
+		frame := int(p.GetElapsedTime() / indeterminateFrameDuration)
+		position = frame % (2 * span)
+		if position > span {
+			position = 2*span - position
+		}
+	}
+
+	return strings.Repeat(p.BarFiller, position) +
+		p.BarStyle.Sprint(strings.Repeat(p.BarCharacter, blockLength)) +
+		strings.Repeat(p.BarFiller, span-position)
+}
+
+// IsIndeterminate returns true if the total of the ProgressbarPrinter is unknown.
+// An indeterminate ProgressbarPrinter shows an animation instead of the progress, until SetTotal is called.
+func (p *ProgressbarPrinter) IsIndeterminate() bool {
+	unlock := p.lock()
+	defer unlock()
+
+	return p.isIndeterminate()
+}
+
+func (p *ProgressbarPrinter) isIndeterminate() bool {
+	return p.Total <= 0
+}
+
+// SetTotal sets the total value of the ProgressbarPrinter and re-renders it.
+// It can be used to switch an indeterminate ProgressbarPrinter to a determinate one, once the total is known.
+// It is safe to call SetTotal from multiple goroutines.
+func (p *ProgressbarPrinter) SetTotal(total int) *ProgressbarPrinter {
+	unlock := p.lock()
+	p.Total = total
+	p.updateProgress()
+	isDone := p.checkDone()
+	unlock()
+
+	if isDone {
+		p.Stop()
+	}
+	return p
+}
+
+// Add to current value.
+// If the ProgressbarPrinter is indeterminate, the current value is still counted and shown.
+// It is safe to call Add from multiple goroutines.
+func (p *ProgressbarPrinter) Add(count int) *ProgressbarPrinter {
+	unlock := p.lock()
 	p.Current += count
+	p.addSample()
 	p.updateProgress()
+	isDone := p.checkDone()
+	unlock()
 
-	if p.Current >= p.Total {
-		p.Total = p.Current
-		p.updateProgress()
+	if isDone {
 		p.Stop()
 	}
 	return p
 }
 
+// checkDone returns true, if the ProgressbarPrinter is determinate and the current value reached the total.
+// It has to be called while the ProgressbarPrinter is locked.
+func (p *ProgressbarPrinter) checkDone() bool {
+	if p.isIndeterminate() || p.Current < p.Total {
+		return false
+	}
+	p.Total = p.Current
+	return true
+}
+
 // Start the ProgressbarPrinter.
+// It returns an error, if the Template can't be parsed.
 func (p ProgressbarPrinter) Start(title ...interface{}) (*ProgressbarPrinter, error) {
+	p.template = nil
+	if p.Template != "" {
+		tmpl, err := template.New("progressbar").Parse(p.Template)
+		if err != nil {
+			return &p, fmt.Errorf("could not parse progressbar template: %w", err)
+		}
+		p.template = tmpl
+	}
+
 	cursor.Hide()
 	if RawOutput && p.ShowTitle {
 		Fprintln(p.Writer, p.Title)
 	}
+	p.mu = &sync.Mutex{}
+	p.status = ""
 	p.IsActive = true
 	if len(title) != 0 {
 		p.Title = Sprint(title...)
 	}
-	ActiveProgressBarPrinters = append(ActiveProgressBarPrinters, &p)
 	p.startedAt = time.Now()
+	p.samples = []progressbarSample{{at: p.startedAt, current: p.Current}}
 
-	p.updateProgress()
+	activeProgressBarPrintersMu.Lock()
+	ActiveProgressBarPrinters = append(ActiveProgressBarPrinters, &p)
+	activeProgressBarPrintersMu.Unlock()
 
-	if p.ShowElapsedTime {
-		p.rerenderTask = schedule.Every(time.Second, func() bool {
-			p.updateProgress()
-			return true
-		})
-	}
+	unlock := p.lock()
+	p.render()
+	p.rerenderTask = schedule.Every(p.refreshInterval(), func() bool {
+		p.refresh()
+		return true
+	})
+	unlock()
 
 	return &p, nil
 }
 
 // Stop the ProgressbarPrinter.
+// Pending updates are rendered before the ProgressbarPrinter stops.
 func (p *ProgressbarPrinter) Stop() (*ProgressbarPrinter, error) {
+	unlock := p.lock()
 	if p.rerenderTask != nil && p.rerenderTask.IsActive() {
 		p.rerenderTask.Stop()
 	}
 	cursor.Show()
 
 	if !p.IsActive {
+		unlock()
 		return p, nil
 	}
+	if p.isDirty {
+		p.render()
+	}
 	p.IsActive = false
+	removeActiveProgressBarPrinter(p)
+	unlock()
+
+	// the ProgressbarPrinter is unlocked before printing, as printing refreshes all other active ProgressbarPrinters
 	if p.RemoveWhenDone {
 		fClearLine(p.Writer)
 		Fprinto(p.Writer)
@@ -308,6 +657,66 @@ func (p *ProgressbarPrinter) Stop() (*ProgressbarPrinter, error) {
 	return p, nil
 }
 
+// Success stops the ProgressbarPrinter and repaints it with the prefix of the SuccessPrinter and a message.
+// If no message is given, the title of the ProgressbarPrinter will be reused as the default message.
+func (p *ProgressbarPrinter) Success(message ...interface{}) *ProgressbarPrinter {
+	return p.finish(p.SuccessPrinter, &Success, message)
+}
+
+// Fail stops the ProgressbarPrinter and repaints it with the prefix of the FailPrinter and a message.
+// If no message is given, the title of the ProgressbarPrinter will be reused as the default message.
+func (p *ProgressbarPrinter) Fail(message ...interface{}) *ProgressbarPrinter {
+	return p.finish(p.FailPrinter, &Error, message)
+}
+
+// Warning stops the ProgressbarPrinter and repaints it with the prefix of the WarningPrinter and a message.
+// If no message is given, the title of the ProgressbarPrinter will be reused as the default message.
+func (p *ProgressbarPrinter) Warning(message ...interface{}) *ProgressbarPrinter {
+	return p.finish(p.WarningPrinter, &Warning, message)
+}
+
+// finish replaces the title of the ProgressbarPrinter with a status message, renders it a last time and stops it.
+func (p *ProgressbarPrinter) finish(printer, fallback TextPrinter, message []interface{}) *ProgressbarPrinter {
+	unlock := p.lock()
+	if !p.IsActive {
+		unlock()
+		return p
+	}
+	if printer == nil {
+		printer = fallback
+	}
+	if len(message) == 0 {
+		message = []interface{}{p.Title}
+	}
+	p.status = printer.Sprint(message...)
+	p.render()
+	unlock()
+
+	p.Stop()
+	return p
+}
+
+// removeActiveProgressBarPrinter removes a stopped ProgressbarPrinter from ActiveProgressBarPrinters.
+func removeActiveProgressBarPrinter(p *ProgressbarPrinter) {
+	activeProgressBarPrintersMu.Lock()
+	defer activeProgressBarPrintersMu.Unlock()
+
+	for i, bar := range ActiveProgressBarPrinters {
+		if bar == p {
+			ActiveProgressBarPrinters = append(ActiveProgressBarPrinters[:i:i], ActiveProgressBarPrinters[i+1:]...)
+			return
+		}
+	}
+}
+
+// activeProgressBarPrinters returns a copy of ActiveProgressBarPrinters, which can be used without holding the lock.
+func activeProgressBarPrinters() []*ProgressbarPrinter {
+	activeProgressBarPrintersMu.Lock()
+	defer activeProgressBarPrintersMu.Unlock()
+
+	return append([]*ProgressbarPrinter{}, ActiveProgressBarPrinters...)
+}
+
 // GenericStart runs Start, but returns a LivePrinter.
 // This is used for the interface LivePrinter.
 // You most likely want to use Start instead of this in your program.
@@ -335,3 +744,80 @@ func (p *ProgressbarPrinter) parseElapsedTime() string {
 	s := p.GetElapsedTime().Round(p.ElapsedTimeRoundingFactor).String()
 	return s
 }
+
+// GetRate returns the number of units per second, at which the ProgressbarPrinter progresses.
+// The rate is averaged over the RateWindow.
+func (p *ProgressbarPrinter) GetRate() float64 {
+	unlock := p.lock()
+	defer unlock()
+
+	return p.rate()
+}
+
+func (p *ProgressbarPrinter) rate() float64 {
+	if len(p.samples) == 0 {
+		return 0
+	}
+
+	first := p.samples[0]
+	elapsed := time.Since(first.at)
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return float64(p.Current-first.current) / elapsed.Seconds()
+}
+
+// GetRemainingTime returns the estimated time until the ProgressbarPrinter is done, based on its current rate.
+// It returns zero if the remaining time can't be estimated yet.
+func (p *ProgressbarPrinter) GetRemainingTime() time.Duration {
+	unlock := p.lock()
+	defer unlock()
+
+	return p.remainingTime()
+}
+
+func (p *ProgressbarPrinter) remainingTime() time.Duration {
+	rate := p.rate()
+	if rate <= 0 || p.Current >= p.Total {
+		return 0
+	}
+
+	return time.Duration(float64(p.Total-p.Current) / rate * float64(time.Second))
+}
+
+// addSample records the current value, which is used to calculate the rate.
+// Samples, which are older than the RateWindow, are dropped, except for the newest of them,
+// so that the rate is always averaged over the full RateWindow.
+func (p *ProgressbarPrinter) addSample() {
+	now := time.Now()
+	if p.RateWindow > 0 {
+		// limit the number of samples, if the progressbar is updated very often
+		if len(p.samples) > 1 && now.Sub(p.samples[len(p.samples)-1].at) < p.RateWindow/10 {
+			return
+		}
+		for len(p.samples) > 1 && now.Sub(p.samples[1].at) >= p.RateWindow {
+			p.samples = p.samples[1:]
+		}
+	} else if len(p.samples) > 0 {
+		return
+	}
+
+	p.samples = append(p.samples, progressbarSample{at: now, current: p.Current})
+}
+
+func (p *ProgressbarPrinter) parseRate() string {
+	rate := p.rate()
+	if p.Unit == ProgressbarUnitBytes {
+		return internal.FormatBytes(int64(rate)) + "/s"
+	}
+	return strconv.FormatFloat(rate, 'f', 1, 64) + "/s"
+}
+
+func (p *ProgressbarPrinter) parseRemainingTime() string {
+	remaining := p.remainingTime()
+	if remaining == 0 && p.Current < p.Total {
+		return "?"
+	}
+	return remaining.Round(p.ElapsedTimeRoundingFactor).String()
+}
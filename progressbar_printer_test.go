@@ -1,8 +1,11 @@
 package pterm_test
 
 import (
+	"bytes"
 	"io"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -42,7 +45,8 @@ func TestProgressbarPrinter_AddWithTotalOfZero(t *testing.T) {
 	proxyToDevNull()
 	p := pterm.ProgressbarPrinter{}.WithTotal(0)
 	p.Add(1337)
-	testza.AssertEqual(t, 0, p.Current)
+	testza.AssertEqual(t, 1337, p.Current)
+	testza.AssertTrue(t, p.IsIndeterminate())
 	p.Stop()
 }
 
@@ -258,3 +262,287 @@ func TestProgressbarPrinter_OutputToWriters(t *testing.T) {
 		})
 	}
 }
+
+func TestProgressbarPrinter_WithShowRate(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithShowRate()
+
+	testza.AssertTrue(t, p2.ShowRate)
+}
+
+func TestProgressbarPrinter_WithShowRemainingTime(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithShowRemainingTime()
+
+	testza.AssertTrue(t, p2.ShowRemainingTime)
+}
+
+func TestProgressbarPrinter_WithUnit(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithUnit(pterm.ProgressbarUnitBytes)
+
+	testza.AssertEqual(t, pterm.ProgressbarUnitBytes, p2.Unit)
+}
+
+func TestProgressbarPrinter_WithRateWindow(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithRateWindow(time.Minute)
+
+	testza.AssertEqual(t, time.Minute, p2.RateWindow)
+}
+
+func TestProgressbarPrinter_GetRate(t *testing.T) {
+	p, _ := pterm.DefaultProgressbar.WithTotal(1000).WithWriter(io.Discard).Start()
+	time.Sleep(100 * time.Millisecond)
+	p.Add(100)
+
+	testza.AssertGreater(t, p.GetRate(), 0)
+	testza.AssertLess(t, p.GetRate(), 1001)
+	testza.AssertGreater(t, p.GetRemainingTime().Seconds(), 0)
+	testza.AssertLess(t, p.GetRemainingTime().Seconds(), 10)
+	p.Stop()
+}
+
+func TestProgressbarPrinter_GetRateWithoutProgress(t *testing.T) {
+	p, _ := pterm.DefaultProgressbar.WithTotal(1000).WithWriter(io.Discard).Start()
+
+	testza.AssertZero(t, p.GetRate())
+	testza.AssertZero(t, p.GetRemainingTime())
+	p.Stop()
+}
+
+func TestProgressbarPrinter_GetRateWithoutRateWindow(t *testing.T) {
+	p, _ := pterm.DefaultProgressbar.WithTotal(1000).WithRateWindow(0).WithWriter(io.Discard).Start()
+	time.Sleep(50 * time.Millisecond)
+	p.Add(10)
+	p.Add(10)
+
+	testza.AssertGreater(t, p.GetRate(), 0)
+	p.Stop()
+}
+
+func TestProgressbarPrinter_ShowRateAndRemainingTimeInBytes(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(10 * 1024 * 1024).WithUnit(pterm.ProgressbarUnitBytes).
+		WithShowRate().WithShowRemainingTime().WithWriter(&buf).Start()
+	time.Sleep(10 * time.Millisecond)
+	p.Add(1024 * 1024)
+	p.Stop()
+
+	output := pterm.RemoveColorFromString(buf.String())
+	testza.AssertContains(t, output, "[1.0 MiB/10.0 MiB]")
+	testza.AssertContains(t, output, "/s | ETA ")
+}
+
+func TestProgressbarPrinter_ShowRateAsCount(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(100).WithShowElapsedTime(false).WithShowRate().WithShowRemainingTime().WithWriter(&buf).Start()
+	p.Stop()
+
+	output := pterm.RemoveColorFromString(buf.String())
+	testza.AssertContains(t, output, "| 0.0/s | ETA ?")
+}
+
+func TestProgressbarPrinter_Indeterminate(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(0).WithShowRemainingTime().WithWriter(&buf).Start()
+	p.Add(5)
+	time.Sleep(250 * time.Millisecond)
+
+	testza.AssertTrue(t, p.IsActive)
+	p.Stop()
+
+	output := pterm.RemoveColorFromString(buf.String())
+	testza.AssertContains(t, output, "[5]")
+	testza.AssertNotContains(t, output, "%")
+	testza.AssertNotContains(t, output, "ETA")
+}
+
+func TestProgressbarPrinter_IndeterminateInBytes(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(0).WithUnit(pterm.ProgressbarUnitBytes).WithWriter(&buf).Start()
+	p.Add(2048)
+	p.Stop()
+
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), "[2.0 KiB]")
+}
+
+func TestProgressbarPrinter_IndeterminateWithSmallTerminal(t *testing.T) {
+	w, h := pterm.GetTerminalWidth(), pterm.GetTerminalHeight()
+	pterm.SetForcedTerminalSize(1, 1)
+	defer pterm.SetForcedTerminalSize(w, h)
+
+	p, _ := pterm.DefaultProgressbar.WithTotal(0).WithWriter(io.Discard).Start()
+	p.Add(1)
+	testza.AssertEqual(t, 1, p.Current)
+	p.Stop()
+}
+
+func TestProgressbarPrinter_SetTotal(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(0).WithWriter(&buf).Start()
+	p.Add(5)
+	p.SetTotal(10)
+	time.Sleep(200 * time.Millisecond)
+
+	testza.AssertFalse(t, p.IsIndeterminate())
+	testza.AssertTrue(t, p.IsActive)
+
+	p.Add(5)
+	testza.AssertFalse(t, p.IsActive)
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), "[05/10]")
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), "[10/10]")
+}
+
+func TestProgressbarPrinter_SetTotalBelowCurrent(t *testing.T) {
+	p, _ := pterm.DefaultProgressbar.WithTotal(0).WithWriter(io.Discard).Start()
+	p.Add(5)
+	p.SetTotal(3)
+
+	testza.AssertFalse(t, p.IsActive)
+	testza.AssertEqual(t, 5, p.Total)
+}
+
+func TestProgressbarPrinter_WithRefreshInterval(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithRefreshInterval(time.Second)
+
+	testza.AssertEqual(t, time.Second, p2.RefreshInterval)
+}
+
+func TestProgressbarPrinter_ConcurrentAdd(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(1000).WithWriter(&buf).Start()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				p.Increment()
+			}
+		}()
+	}
+	wg.Wait()
+
+	testza.AssertEqual(t, 1000, p.Current)
+	testza.AssertFalse(t, p.IsActive)
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), "[1000/1000]")
+	testza.AssertLess(t, strings.Count(buf.String(), "\r"), 100)
+	testza.AssertNotContains(t, pterm.ActiveProgressBarPrinters, p)
+}
+
+func TestProgressbarPrinter_StopRendersPendingUpdates(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithTotal(100).WithRefreshInterval(time.Hour).WithWriter(&buf).Start()
+	p.Add(42)
+	testza.AssertNotContains(t, pterm.RemoveColorFromString(buf.String()), "[042/100]")
+
+	p.Stop()
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), "[042/100]")
+}
+
+func TestProgressbarPrinter_WithTemplate(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithTemplate("{{.Bar}}")
+
+	testza.AssertEqual(t, "{{.Bar}}", p2.Template)
+}
+
+func TestProgressbarPrinter_WithSuccessPrinter(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithSuccessPrinter(&pterm.Info)
+
+	testza.AssertEqual(t, &pterm.Info, p2.SuccessPrinter)
+}
+
+func TestProgressbarPrinter_WithFailPrinter(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithFailPrinter(&pterm.Info)
+
+	testza.AssertEqual(t, &pterm.Info, p2.FailPrinter)
+}
+
+func TestProgressbarPrinter_WithWarningPrinter(t *testing.T) {
+	p := pterm.ProgressbarPrinter{}
+	p2 := p.WithWarningPrinter(&pterm.Info)
+
+	testza.AssertEqual(t, &pterm.Info, p2.WarningPrinter)
+}
+
+func TestProgressbarPrinter_Template(t *testing.T) {
+	var buf bytes.Buffer
+	p, err := pterm.DefaultProgressbar.WithTitle("Test").WithMaxWidth(40).
+		WithTemplate("{{.Title}}: {{.Current}} of {{.Total}} |{{.Bar}}| {{.Percent}}%").WithWriter(&buf).Start()
+	testza.AssertNoError(t, err)
+	p.Add(50)
+	p.Stop()
+
+	lines := strings.Split(pterm.RemoveColorFromString(buf.String()), "\r")
+	line := strings.TrimSpace(lines[len(lines)-1])
+	testza.AssertTrue(t, strings.HasPrefix(line, "Test: 50 of 100 |"))
+	testza.AssertTrue(t, strings.HasSuffix(line, "| 50%"))
+	testza.AssertEqual(t, 40, len([]rune(line)))
+}
+
+func TestProgressbarPrinter_TemplateWithInvalidSyntax(t *testing.T) {
+	p, err := pterm.DefaultProgressbar.WithTemplate("{{.Title").WithWriter(io.Discard).Start()
+
+	testza.AssertNotNil(t, err)
+	testza.AssertFalse(t, p.IsActive)
+}
+
+func TestProgressbarPrinter_TemplateWithUnknownField(t *testing.T) {
+	var buf bytes.Buffer
+	p, err := pterm.DefaultProgressbar.WithTemplate("{{.Unknown}}").WithWriter(&buf).Start()
+	testza.AssertNoError(t, err)
+	p.Stop()
+
+	testza.AssertContains(t, buf.String(), "Unknown")
+}
+
+func TestProgressbarPrinter_Finalizers(t *testing.T) {
+	tests := []struct {
+		name     string
+		finish   func(p *pterm.ProgressbarPrinter, message ...interface{}) *pterm.ProgressbarPrinter
+		expected string
+	}{
+		{"Success", (*pterm.ProgressbarPrinter).Success, "SUCCESS"},
+		{"Fail", (*pterm.ProgressbarPrinter).Fail, "ERROR"},
+		{"Warning", (*pterm.ProgressbarPrinter).Warning, "WARNING"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p, _ := pterm.DefaultProgressbar.WithTitle("Downloading").WithWriter(&buf).Start()
+			p.Add(10)
+			test.finish(p, "Done")
+
+			output := pterm.RemoveColorFromString(buf.String())
+			testza.AssertFalse(t, p.IsActive)
+			testza.AssertContains(t, output, test.expected)
+			testza.AssertContains(t, output, "Done [010/100]")
+		})
+	}
+}
+
+func TestProgressbarPrinter_FinalizerWithoutMessage(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.ProgressbarPrinter{Total: 10, ShowTitle: true, Title: "Downloading"}.WithWriter(&buf).Start()
+	p.Success()
+
+	output := pterm.RemoveColorFromString(buf.String())
+	testza.AssertContains(t, output, "SUCCESS")
+	testza.AssertContains(t, output, "Downloading")
+}
+
+func TestProgressbarPrinter_FinalizerAfterStop(t *testing.T) {
+	var buf bytes.Buffer
+	p, _ := pterm.DefaultProgressbar.WithWriter(&buf).Start()
+	p.Stop()
+	p.Fail("Failed")
+
+	testza.AssertNotContains(t, buf.String(), "Failed")
+}
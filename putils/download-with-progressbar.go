@@ -6,26 +6,13 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
-	"strconv"
 
 	"github.com/pterm/pterm"
 )
 
-// progressbarWriter counts the number of bytes written to it and adds those to a progressbar.
-type progressbarWriter struct {
-	Total uint64
-	pb    *pterm.ProgressbarPrinter
-}
-
-func (w *progressbarWriter) Write(p []byte) (int, error) {
-	n := len(p)
-	w.Total += uint64(n)
-	w.pb.Add(len(p))
-	return n, nil
-}
-
 // DownloadFileWithProgressbar downloads a file, by url, and writes it to outputPath.
 // The download progress, will be reported via a progressbar.
+// If the server does not report the size of the file, the progressbar is indeterminate.
 func DownloadFileWithProgressbar(progressbar *pterm.ProgressbarPrinter, outputPath, url string, mode os.FileMode) error {
 	path := filepath.Clean(outputPath)
 	out, err := os.Create(path)
@@ -40,14 +27,15 @@ func DownloadFileWithProgressbar(progressbar *pterm.ProgressbarPrinter, outputPa
 	}
 	defer resp.Body.Close()
 
-	counter := &progressbarWriter{}
-	fileSize, err := strconv.Atoi(resp.Header.Get("Content-Length"))
+	// if the file size is unknown, the content length is -1 and the progressbar is indeterminate
+	reader, err := NewProgressbarReader(progressbar, resp.Body, resp.ContentLength)
 	if err != nil {
-		return fmt.Errorf("could not determine file size: %w", err)
+		out.Close()
+		return err
 	}
-
-	counter.pb, _ = progressbar.WithTotal(fileSize).Start()
-	if _, err = io.Copy(out, io.TeeReader(resp.Body, counter)); err != nil {
+	_, err = io.Copy(out, reader)
+	reader.Close()
+	if err != nil {
 		out.Close()
 		return err
 	}
@@ -62,7 +50,8 @@ func DownloadFileWithProgressbar(progressbar *pterm.ProgressbarPrinter, outputPa
 }
 
 // DownloadFileWithDefaultProgressbar downloads a file, by url, and writes it to outputPath.
-// The download progress, will be reported via the default progressbar.
+// The download progress, will be reported via the default progressbar, showing the size, rate and remaining time.
 func DownloadFileWithDefaultProgressbar(title, outputPath, url string, mode os.FileMode) error {
-	return DownloadFileWithProgressbar(pterm.DefaultProgressbar.WithTitle(title), outputPath, url, mode)
+	progressbar := pterm.DefaultProgressbar.WithTitle(title).WithShowRate().WithShowRemainingTime()
+	return DownloadFileWithProgressbar(progressbar, outputPath, url, mode)
 }
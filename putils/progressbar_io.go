@@ -0,0 +1,190 @@
+package putils
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/pterm/pterm"
+)
+
+// ProgressbarReader is an io.Reader, which adds the number of read bytes to a progressbar.
+// The progressbar stops, when the underlying reader returns io.EOF, or when the ProgressbarReader is closed.
+type ProgressbarReader struct {
+	// Progressbar is the started progressbar, which shows the progress of the reader.
+	Progressbar *pterm.ProgressbarPrinter
+
+	reader io.Reader
+	stream *progressbarStream
+}
+
+// NewProgressbarReader starts the progressbar and returns a ProgressbarReader, which reports the progress of reader to it.
+// The size is the total number of bytes, which will be read. If the size is unknown, pass zero, and the progressbar is indeterminate.
+//
+// Usage:
+//
+//	reader, _ := putils.NewProgressbarReader(pterm.DefaultProgressbar.WithTitle("Reading file"), file, fileSize)
+//	defer reader.Close()
+//	io.Copy(io.Discard, reader)
+func NewProgressbarReader(progressbar *pterm.ProgressbarPrinter, reader io.Reader, size int64) (*ProgressbarReader, error) {
+	return NewProgressbarReaderWithContext(context.Background(), progressbar, reader, size)
+}
+
+// NewProgressbarReaderWithContext works like NewProgressbarReader, but stops the progressbar in a failed state, when the context is cancelled.
+// After the context is cancelled, Read returns the error of the context.
+func NewProgressbarReaderWithContext(ctx context.Context, progressbar *pterm.ProgressbarPrinter, reader io.Reader, size int64) (*ProgressbarReader, error) {
+	stream, err := startProgressbarStream(ctx, progressbar, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProgressbarReader{
+		Progressbar: stream.progressbar,
+		reader:      reader,
+		stream:      stream,
+	}, nil
+}
+
+// Read reads from the underlying reader and adds the number of read bytes to the progressbar.
+func (r *ProgressbarReader) Read(p []byte) (int, error) {
+	if err := r.stream.err(); err != nil {
+		return 0, err
+	}
+
+	n, err := r.reader.Read(p)
+	r.stream.add(n)
+	if err == io.EOF {
+		r.stream.stop()
+	}
+
+	return n, err
+}
+
+// Close stops the progressbar and closes the underlying reader, if it implements io.Closer.
+func (r *ProgressbarReader) Close() error {
+	r.stream.stop()
+	if closer, ok := r.reader.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// ProgressbarWriter is an io.Writer, which adds the number of written bytes to a progressbar.
+// The progressbar stops, when the total size is written, or when the ProgressbarWriter is closed.
+type ProgressbarWriter struct {
+	// Progressbar is the started progressbar, which shows the progress of the writer.
+	Progressbar *pterm.ProgressbarPrinter
+
+	writer io.Writer
+	stream *progressbarStream
+}
+
+// NewProgressbarWriter starts the progressbar and returns a ProgressbarWriter, which reports the progress of writer to it.
+// The size is the total number of bytes, which will be written. If the size is unknown, pass zero, and the progressbar is indeterminate.
+//
+// Usage:
+//
+//	writer, _ := putils.NewProgressbarWriter(pterm.DefaultProgressbar.WithTitle("Writing file"), file, size)
+//	defer writer.Close()
+//	io.Copy(writer, reader)
+func NewProgressbarWriter(progressbar *pterm.ProgressbarPrinter, writer io.Writer, size int64) (*ProgressbarWriter, error) {
+	return NewProgressbarWriterWithContext(context.Background(), progressbar, writer, size)
+}
+
+// NewProgressbarWriterWithContext works like NewProgressbarWriter, but stops the progressbar in a failed state, when the context is cancelled.
+// After the context is cancelled, Write returns the error of the context.
+func NewProgressbarWriterWithContext(ctx context.Context, progressbar *pterm.ProgressbarPrinter, writer io.Writer, size int64) (*ProgressbarWriter, error) {
+	stream, err := startProgressbarStream(ctx, progressbar, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProgressbarWriter{
+		Progressbar: stream.progressbar,
+		writer:      writer,
+		stream:      stream,
+	}, nil
+}
+
+// Write writes to the underlying writer and adds the number of written bytes to the progressbar.
+func (w *ProgressbarWriter) Write(p []byte) (int, error) {
+	if err := w.stream.err(); err != nil {
+		return 0, err
+	}
+
+	n, err := w.writer.Write(p)
+	w.stream.add(n)
+
+	return n, err
+}
+
+// Close stops the progressbar and closes the underlying writer, if it implements io.Closer.
+func (w *ProgressbarWriter) Close() error {
+	w.stream.stop()
+	if closer, ok := w.writer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// progressbarStream contains the logic shared by ProgressbarReader and ProgressbarWriter.
+type progressbarStream struct {
+	ctx         context.Context
+	progressbar *pterm.ProgressbarPrinter
+	title       string
+	stopContext func() bool
+	stopOnce    sync.Once
+}
+
+// startProgressbarStream starts the progressbar with the size as total, and fails it when the context is cancelled.
+func startProgressbarStream(ctx context.Context, progressbar *pterm.ProgressbarPrinter, size int64) (*progressbarStream, error) {
+	if size < 0 {
+		size = 0
+	}
+
+	pb, err := progressbar.WithTotal(int(size)).WithCurrent(0).WithUnit(pterm.ProgressbarUnitBytes).Start()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &progressbarStream{
+		ctx:         ctx,
+		progressbar: pb,
+		title:       pb.Title,
+	}
+	s.stopContext = context.AfterFunc(ctx, s.fail)
+
+	return s, nil
+}
+
+// err returns the error of the context, after making sure that the progressbar is failed.
+func (s *progressbarStream) err() error {
+	err := s.ctx.Err()
+	if err != nil {
+		// the progressbar is failed asynchronously when the context is cancelled, so wait for it
+		s.fail()
+	}
+	return err
+}
+
+// add adds the number of transferred bytes to the progressbar.
+func (s *progressbarStream) add(n int) {
+	if n > 0 {
+		s.progressbar.Add(n)
+	}
+}
+
+// stop stops the progressbar, if it is still running.
+func (s *progressbarStream) stop() {
+	s.stopOnce.Do(func() {
+		s.stopContext()
+		s.progressbar.Stop()
+	})
+}
+
+// fail stops the progressbar in a failed state, showing the error of the context.
+func (s *progressbarStream) fail() {
+	s.stopOnce.Do(func() {
+		s.progressbar.Fail(s.title + " (" + s.ctx.Err().Error() + ")")
+	})
+}
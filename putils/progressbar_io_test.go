@@ -0,0 +1,93 @@
+package putils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm"
+)
+
+func TestProgressbarReader(t *testing.T) {
+	reader, err := NewProgressbarReader(pterm.DefaultProgressbar.WithWriter(io.Discard), strings.NewReader("Hello, World!"), 13)
+	testza.AssertNoError(t, err)
+
+	content, err := io.ReadAll(reader)
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Hello, World!", string(content))
+	testza.AssertEqual(t, 13, reader.Progressbar.Current)
+	testza.AssertEqual(t, pterm.ProgressbarUnitBytes, reader.Progressbar.Unit)
+	testza.AssertFalse(t, reader.Progressbar.IsActive)
+	testza.AssertNoError(t, reader.Close())
+}
+
+func TestProgressbarReaderWithUnknownSize(t *testing.T) {
+	reader, err := NewProgressbarReader(pterm.DefaultProgressbar.WithWriter(io.Discard), strings.NewReader("Hello, World!"), -1)
+	testza.AssertNoError(t, err)
+	testza.AssertTrue(t, reader.Progressbar.IsIndeterminate())
+
+	_, err = io.Copy(io.Discard, reader)
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, 13, reader.Progressbar.Current)
+	testza.AssertFalse(t, reader.Progressbar.IsActive)
+}
+
+func TestProgressbarReaderWithCancelledContext(t *testing.T) {
+	var buf bytes.Buffer
+	ctx, cancel := context.WithCancel(context.Background())
+	reader, err := NewProgressbarReaderWithContext(ctx, pterm.DefaultProgressbar.WithTitle("Reading").WithWriter(&buf), strings.NewReader("Hello, World!"), 13)
+	testza.AssertNoError(t, err)
+
+	cancel()
+	_, err = reader.Read(make([]byte, 5))
+
+	testza.AssertTrue(t, errors.Is(err, context.Canceled))
+	testza.AssertFalse(t, reader.Progressbar.IsActive)
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), "ERROR")
+	testza.AssertContains(t, pterm.RemoveColorFromString(buf.String()), "Reading (context canceled)")
+}
+
+type testWriteCloser struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (w *testWriteCloser) Close() error {
+	w.closed = true
+	return nil
+}
+
+func TestProgressbarWriter(t *testing.T) {
+	var out testWriteCloser
+	writer, err := NewProgressbarWriter(pterm.DefaultProgressbar.WithWriter(io.Discard), &out, 0)
+	testza.AssertNoError(t, err)
+
+	_, err = io.Copy(writer, strings.NewReader("Hello, World!"))
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, 13, writer.Progressbar.Current)
+	testza.AssertTrue(t, writer.Progressbar.IsActive)
+
+	testza.AssertNoError(t, writer.Close())
+	testza.AssertFalse(t, writer.Progressbar.IsActive)
+	testza.AssertTrue(t, out.closed)
+	testza.AssertEqual(t, "Hello, World!", out.String())
+}
+
+func TestProgressbarWriterWithCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	writer, err := NewProgressbarWriterWithContext(ctx, pterm.DefaultProgressbar.WithWriter(io.Discard), io.Discard, 100)
+	testza.AssertNoError(t, err)
+
+	_, err = writer.Write([]byte("Hello"))
+	testza.AssertNoError(t, err)
+
+	cancel()
+	_, err = writer.Write([]byte("World"))
+
+	testza.AssertTrue(t, errors.Is(err, context.Canceled))
+	testza.AssertEqual(t, 5, writer.Progressbar.Current)
+}
@@ -1,18 +1,59 @@
 package putils
 
 import (
+	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pterm/pterm"
+	"github.com/pterm/pterm/internal"
 )
 
+// structColumn is a column of a table created from a struct slice.
+type structColumn struct {
+	index      []int
+	header     string
+	order      int
+	alignment  pterm.TableAlignment
+	format     string
+	timeLayout string
+}
+
 // TableFromStructSlice accepts a customized table printer and and a slice of a struct.
 // The table will be populated with the values of the structs. The header will be set to the structs field name.
 // Use .WithHasHeader() to color the header.
 // The function will return the populated pterm.TablePrinter.
+//
+// The columns can be customized with `pterm:"..."` struct tags.
+// The first value of the tag is the header of the column, an empty value keeps the field name.
+// A tag of "-" omits the field. The following options can be added, separated by commas:
+//
+//	order=N         sorts the column by N, which defaults to 0. Columns with the same order keep their field order.
+//	align=left      aligns the column to the left, center or right.
+//	format=bytes    formats an integer as a human-readable byte size, like "1.5 MiB".
+//	format=duration formats a time.Duration rounded to a readable precision.
+//	format=%.2f     formats the value with a fmt verb.
+//	time=layout     formats a time.Time with a time layout. It has to be the last option, as the layout may contain commas.
+//	inline          adds the fields of a nested struct as columns, like the fields of an embedded struct.
+//
+// Example:
+//
+//	type File struct {
+//		Name    string    `pterm:"File name"`
+//		Size    int64     `pterm:"Size,align=right,format=bytes"`
+//		ModTime time.Time `pterm:"Modified,time=2006-01-02 15:04"`
+//		Hash    string    `pterm:"-"`
+//	}
+//
+// Fields of embedded structs are added as columns. Nil pointers are rendered as empty cells,
+// values implementing fmt.Stringer or error are rendered with their String or Error method,
+// and nil elements of a slice of pointers to structs are skipped.
 func TableFromStructSlice(tablePrinter pterm.TablePrinter, structSlice interface{}) *pterm.TablePrinter {
 	to := reflect.TypeOf(structSlice)
-	if to.Kind() != reflect.Slice {
+	if to == nil || to.Kind() != reflect.Slice {
 		return &tablePrinter
 	}
 	el := to.Elem()
@@ -27,45 +68,259 @@ func TableFromStructSlice(tablePrinter pterm.TablePrinter, structSlice interface
 		return &tablePrinter
 	}
 
-	numFields := el.NumField()
-	fieldNames := make([]string, numFields)
+	columns := structColumns(el, nil, map[reflect.Type]bool{})
+	sort.SliceStable(columns, func(i, j int) bool {
+		return columns[i].order < columns[j].order
+	})
 
-	for i := 0; i < numFields; i++ {
-		fieldNames[i] = el.Field(i).Name
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.header
 	}
 
 	records := pterm.TableData{
-		fieldNames,
+		header,
 	}
 
 	obj := reflect.ValueOf(structSlice)
-
-	items := make([]interface{}, obj.Len())
 	for i := 0; i < obj.Len(); i++ {
+		item := obj.Index(i)
 		if isPointer {
-			items[i] = obj.Index(i).Elem().Interface()
-		} else {
-			items[i] = obj.Index(i).Interface()
+			if item.IsNil() {
+				continue
+			}
+			item = item.Elem()
 		}
-	}
 
-	for _, v := range items {
-		item := reflect.ValueOf(v)
-		record := make([]string, numFields)
-		for i := 0; i < numFields; i++ {
-			fieldVal := item.Field(i).Interface()
-			record[i] = pterm.Sprintf("%v", fieldVal)
+		record := make([]string, len(columns))
+		for j, c := range columns {
+			if field, ok := structField(item, c.index); ok {
+				record[j] = c.formatValue(field)
+			}
 		}
 		records = append(records, record)
 	}
 	tablePrinter.Data = records
 
+	var hasAlignment bool
+	for _, c := range columns {
+		hasAlignment = hasAlignment || c.alignment != pterm.TableAlignmentDefault
+	}
+	if hasAlignment {
+		tableColumns := make([]pterm.TableColumn, max(len(columns), len(tablePrinter.Columns)))
+		copy(tableColumns, tablePrinter.Columns)
+		for i, c := range columns {
+			if c.alignment != pterm.TableAlignmentDefault {
+				tableColumns[i].Alignment = c.alignment
+			}
+		}
+		tablePrinter.Columns = tableColumns
+	}
+
 	return &tablePrinter
 }
 
 // DefaultTableFromStructSlice will be populate the pterm.DefaultTable with the values of the structs. The header will be set to the structs field name.
 // Use .WithHasHeader() to color the header.
 // The function will return the populated pterm.TablePrinter.
+// See TableFromStructSlice for the supported struct tags.
 func DefaultTableFromStructSlice(structSlice interface{}) *pterm.TablePrinter {
 	return TableFromStructSlice(pterm.DefaultTable, structSlice)
 }
+
+// structColumns returns the columns of a struct type, including the fields of embedded and inlined structs.
+// The visited types are tracked to prevent endless recursion on self-referencing structs.
+func structColumns(t reflect.Type, index []int, visited map[reflect.Type]bool) []structColumn {
+	if visited[t] {
+		return nil
+	}
+	visited[t] = true
+	defer delete(visited, t)
+
+	var columns []structColumn
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("pterm")
+		if tag == "-" {
+			continue
+		}
+
+		c := parseStructTag(tag)
+		c.index = append(append([]int{}, index...), i)
+		if c.header == "" {
+			c.header = field.Name
+		}
+
+		// unexported fields are skipped, except embedded structs, whose exported fields are promoted
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if isNestedStruct(fieldType) && (field.Anonymous || strings.Contains(","+tag+",", ",inline,")) {
+			nested := structColumns(fieldType, c.index, visited)
+			for j := range nested {
+				nested[j].order += c.order
+			}
+			columns = append(columns, nested...)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		columns = append(columns, c)
+	}
+
+	return columns
+}
+
+// isNestedStruct returns true if the fields of a struct type can be added as columns.
+// Structs, which are formatted as a single value, like time.Time or fmt.Stringer implementations, are not nested.
+func isNestedStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || t == reflect.TypeOf(time.Time{}) {
+		return false
+	}
+	for _, i := range []reflect.Type{reflect.TypeOf((*fmt.Stringer)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()} {
+		if t.Implements(i) || reflect.PointerTo(t).Implements(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseStructTag parses the value of a `pterm:"..."` struct tag.
+func parseStructTag(tag string) structColumn {
+	var c structColumn
+
+	parts := strings.Split(tag, ",")
+	c.header = parts[0]
+	for i := 1; i < len(parts); i++ {
+		key, value, _ := strings.Cut(parts[i], "=")
+		switch key {
+		case "order":
+			c.order, _ = strconv.Atoi(value)
+		case "align":
+			switch value {
+			case "left":
+				c.alignment = pterm.TableAlignmentLeft
+			case "center":
+				c.alignment = pterm.TableAlignmentCenter
+			case "right":
+				c.alignment = pterm.TableAlignmentRight
+			}
+		case "format":
+			c.format = value
+		case "time":
+			// the time layout may contain commas, so it consumes the rest of the tag
+			c.timeLayout = strings.Join(append([]string{value}, parts[i+1:]...), ",")
+			return c
+		}
+	}
+
+	return c
+}
+
+// structField returns the field of a struct value at the given index path.
+// It returns false if a pointer to an embedded struct on the path is nil.
+func structField(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// formatValue formats the value of a field according to the options of the column.
+func (c structColumn) formatValue(v reflect.Value) string {
+	for {
+		if s, ok := stringerValue(v); ok {
+			return s
+		}
+		if v.Kind() != reflect.Ptr && v.Kind() != reflect.Interface {
+			break
+		}
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() || !v.CanInterface() {
+		return ""
+	}
+
+	value := v.Interface()
+	switch {
+	case c.timeLayout != "":
+		if t, ok := value.(time.Time); ok {
+			return t.Format(c.timeLayout)
+		}
+	case c.format == "bytes":
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return internal.FormatBytes(v.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return internal.FormatBytes(int64(v.Uint()))
+		}
+	case c.format == "duration":
+		if d, ok := value.(time.Duration); ok {
+			return formatDuration(d)
+		}
+	case strings.HasPrefix(c.format, "%"):
+		return pterm.Sprintf(c.format, value)
+	}
+
+	return pterm.Sprintf("%v", value)
+}
+
+// stringerValue returns the result of the String or Error method of a value, if it has one.
+// time.Time is excluded, so that it can be formatted with a time layout.
+func stringerValue(v reflect.Value) (string, bool) {
+	if !v.IsValid() || !v.CanInterface() || v.Type() == reflect.TypeOf(time.Time{}) {
+		return "", false
+	}
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", false
+		}
+	}
+
+	switch value := v.Interface().(type) {
+	case error:
+		return value.Error(), true
+	case fmt.Stringer:
+		if _, isDuration := value.(time.Duration); isDuration {
+			return "", false
+		}
+		return value.String(), true
+	}
+
+	return "", false
+}
+
+// formatDuration rounds a duration to a readable precision.
+func formatDuration(d time.Duration) string {
+	abs := d
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case abs >= time.Minute:
+		return d.Round(time.Second).String()
+	case abs >= time.Second:
+		return d.Round(10 * time.Millisecond).String()
+	case abs >= time.Millisecond:
+		return d.Round(10 * time.Microsecond).String()
+	}
+	return d.String()
+}
@@ -0,0 +1,105 @@
+package putils
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/pterm/pterm"
+)
+
+type testStructBase struct {
+	ID int `pterm:"ID,order=-1"`
+}
+
+type testStructOwner struct {
+	Name string
+}
+
+type testStruct struct {
+	testStructBase
+	Name     string        `pterm:"File name"`
+	Size     int64         `pterm:"Size,align=right,format=bytes"`
+	Took     time.Duration `pterm:",format=duration"`
+	Ratio    float64       `pterm:",format=%.2f"`
+	Modified time.Time     `pterm:"Modified,time=Jan 2, 2006"`
+	Comment  *string
+	IP       net.IP
+	Err      error
+	Owner    testStructOwner `pterm:",inline"`
+	Hash     string          `pterm:"-"`
+	internal string
+}
+
+func TestTableFromStructSlice(t *testing.T) {
+	comment := "hello"
+	data := []*testStruct{
+		{
+			testStructBase: testStructBase{ID: 1},
+			Name:           "a.txt",
+			Size:           1536,
+			Took:           1234567 * time.Microsecond,
+			Ratio:          0.123,
+			Modified:       time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			Comment:        &comment,
+			IP:             net.IPv4(127, 0, 0, 1),
+			Err:            errors.New("failed"),
+			Owner:          testStructOwner{Name: "Paul"},
+			Hash:           "abc",
+			internal:       "internal",
+		},
+		nil,
+		{Name: "b.txt"},
+	}
+
+	p := TableFromStructSlice(pterm.DefaultTable, data)
+
+	testza.AssertEqual(t, pterm.TableData{
+		{"ID", "File name", "Size", "Took", "Ratio", "Modified", "Comment", "IP", "Err", "Name"},
+		{"1", "a.txt", "1.5 KiB", "1.23s", "0.12", "Mar 1, 2024", "hello", "127.0.0.1", "failed", "Paul"},
+		{"0", "b.txt", "0 B", "0s", "0.00", "Jan 1, 0001", "", "<nil>", "", ""},
+	}, p.Data)
+	testza.AssertEqual(t, pterm.TableAlignmentRight, p.Columns[2].Alignment)
+	testza.AssertEqual(t, pterm.TableAlignmentDefault, p.Columns[1].Alignment)
+}
+
+func TestTableFromStructSliceWithoutTags(t *testing.T) {
+	type person struct {
+		Firstname string
+		Lastname  string
+	}
+
+	p := DefaultTableFromStructSlice([]person{{"Paul", "Dean"}})
+
+	testza.AssertEqual(t, pterm.TableData{{"Firstname", "Lastname"}, {"Paul", "Dean"}}, p.Data)
+	testza.AssertNil(t, p.Columns)
+}
+
+func TestTableFromStructSliceWithNilEmbeddedPointer(t *testing.T) {
+	type person struct {
+		*testStructOwner
+		Age int
+	}
+
+	p := DefaultTableFromStructSlice([]person{{Age: 42}, {&testStructOwner{"Paul"}, 21}})
+
+	testza.AssertEqual(t, pterm.TableData{{"Name", "Age"}, {"", "42"}, {"Paul", "21"}}, p.Data)
+}
+
+func TestTableFromStructSliceWithUnexportedInlineField(t *testing.T) {
+	type person struct {
+		Name  string
+		owner testStructOwner `pterm:",inline"`
+	}
+
+	p := DefaultTableFromStructSlice([]person{{Name: "Paul", owner: testStructOwner{"Dean"}}})
+
+	testza.AssertEqual(t, pterm.TableData{{"Name"}, {"Paul"}}, p.Data)
+}
+
+func TestTableFromStructSliceWithInvalidInput(t *testing.T) {
+	testza.AssertNil(t, DefaultTableFromStructSlice(nil).Data)
+	testza.AssertNil(t, DefaultTableFromStructSlice([]string{"a"}).Data)
+}
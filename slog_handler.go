@@ -2,89 +2,157 @@ package pterm
 
 import (
 	"context"
+	"runtime"
 
 	"log/slog"
 )
 
+// SlogHandler is a slog.Handler, which prints the records with a pterm Logger.
+// Groups are rendered as nested objects by the JSON formatter and as dotted keys by the colorful formatter.
 type SlogHandler struct {
 	logger *Logger
-	attrs  []slog.Attr
+	// args are the resolved attributes of WithAttrs, nested in the groups, which were open when they were added.
+	args []LoggerArgument
+	// groups are the groups of WithGroup, which the attributes of the following records are nested in.
+	groups []string
 }
 
 // Enabled returns true if the given level is enabled.
 func (s *SlogHandler) Enabled(ctx context.Context, level slog.Level) bool {
-	switch level {
-	case slog.LevelDebug:
-		return s.logger.CanPrint(LogLevelDebug)
-	case slog.LevelInfo:
-		return s.logger.CanPrint(LogLevelInfo)
-	case slog.LevelWarn:
-		return s.logger.CanPrint(LogLevelWarn)
-	case slog.LevelError:
-		return s.logger.CanPrint(LogLevelError)
-	}
-	return false
+	return s.logger.CanPrint(SlogLevelToLogLevel(level))
 }
 
 // Handle handles the given record.
 func (s *SlogHandler) Handle(ctx context.Context, record slog.Record) error {
-	level := record.Level
-	message := record.Message
-
-	// Convert slog Attrs to a map.
-	keyValsMap := make(map[string]interface{})
-
+	attrs := make([]slog.Attr, 0, record.NumAttrs())
 	record.Attrs(func(attr slog.Attr) bool {
-		keyValsMap[attr.Key] = attr.Value
+		attrs = append(attrs, attr)
 		return true
 	})
 
-	for _, attr := range s.attrs {
-		keyValsMap[attr.Key] = attr.Value
+	entry := LogEntry{
+		Level:   SlogLevelToLogLevel(record.Level),
+		Time:    record.Time,
+		Message: record.Message,
+		Args:    appendGroupedLoggerArgs(s.args, s.groups, slogAttrsToArgs(attrs)),
 	}
-
-	args := s.logger.ArgsFromMap(keyValsMap)
-
-	// Wrapping args inside another slice to match [][]LoggerArgument
-	argsWrapped := [][]LoggerArgument{args}
-
-	logger := s.logger
-
-	// Must be done here, see https://github.com/pterm/pterm/issues/608#issuecomment-1876001650
-	if logger.CallerOffset == 0 {
-		logger = logger.WithCallerOffset(3)
+	if s.logger.ShowCaller && record.PC != 0 {
+		frame, _ := runtime.CallersFrames([]uintptr{record.PC}).Next()
+		entry.Caller = Sprintf("%s:%d", trimCallerPath(frame.File), frame.Line)
 	}
-
-	switch level {
-	case slog.LevelDebug:
-		logger.Debug(message, argsWrapped...)
-	case slog.LevelInfo:
-		logger.Info(message, argsWrapped...)
-	case slog.LevelWarn:
-		logger.Warn(message, argsWrapped...)
-	case slog.LevelError:
-		logger.Error(message, argsWrapped...)
-	default:
-		logger.Print(message, argsWrapped...)
+	if s.logger.ShowStackTrace && entry.Level == LogLevelError && record.PC != 0 {
+		entry.Stack = slogStackTrace(record.PC)
 	}
 
+	s.logger.printEntry(entry)
+
 	return nil
 }
 
 // WithAttrs returns a new handler with the given attributes.
+// The attributes are added to the attributes of the handler, in the groups which are currently open.
 func (s *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
 	newS := *s
-	newS.attrs = attrs
+	newS.args = appendGroupedLoggerArgs(s.args, s.groups, slogAttrsToArgs(attrs))
 	return &newS
 }
 
-// WithGroup is not yet supported.
+// WithGroup returns a new handler, which nests the attributes of following calls in the group.
 func (s *SlogHandler) WithGroup(name string) slog.Handler {
-	// Grouping is not yet supported by pterm.
-	return s
+	if name == "" {
+		return s
+	}
+
+	newS := *s
+	newS.groups = append(s.groups[:len(s.groups):len(s.groups)], name)
+	return &newS
 }
 
 // NewSlogHandler returns a new logging handler that can be intrgrated with log/slog.
 func NewSlogHandler(logger *Logger) *SlogHandler {
 	return &SlogHandler{logger: logger}
 }
+
+// SlogLevelToLogLevel maps any slog.Level to the LogLevel, which covers it.
+// Levels below slog.LevelDebug are mapped to LogLevelTrace.
+// Levels above slog.LevelError are mapped to LogLevelError, so that they never exit the program.
+func SlogLevelToLogLevel(level slog.Level) LogLevel {
+	switch {
+	case level < slog.LevelDebug:
+		return LogLevelTrace
+	case level < slog.LevelInfo:
+		return LogLevelDebug
+	case level < slog.LevelWarn:
+		return LogLevelInfo
+	case level < slog.LevelError:
+		return LogLevelWarn
+	default:
+		return LogLevelError
+	}
+}
+
+// slogAttrsToArgs converts attributes to logger arguments.
+// Values are resolved, empty attributes and empty groups are dropped, and groups without a key are inlined.
+func slogAttrsToArgs(attrs []slog.Attr) []LoggerArgument {
+	var args []LoggerArgument
+
+	for _, attr := range attrs {
+		attr.Value = attr.Value.Resolve()
+		if attr.Equal(slog.Attr{}) {
+			continue
+		}
+
+		if attr.Value.Kind() != slog.KindGroup {
+			args = append(args, LoggerArgument{Key: attr.Key, Value: attr.Value.Any()})
+			continue
+		}
+
+		group := slogAttrsToArgs(attr.Value.Group())
+		switch {
+		case len(group) == 0:
+		case attr.Key == "":
+			args = append(args, group...)
+		default:
+			args = append(args, LoggerArgument{Key: attr.Key, Value: group})
+		}
+	}
+
+	return args
+}
+
+// appendGroupedLoggerArgs returns a copy of args with newArgs appended to the nested groups.
+// Missing groups are created, but only if there are arguments to add, so that empty groups are never rendered.
+func appendGroupedLoggerArgs(args []LoggerArgument, groups []string, newArgs []LoggerArgument) []LoggerArgument {
+	if len(newArgs) == 0 {
+		return args
+	}
+
+	result := append([]LoggerArgument(nil), args...)
+	if len(groups) == 0 {
+		return append(result, newArgs...)
+	}
+
+	for i := len(result) - 1; i >= 0; i-- {
+		if group, ok := result[i].Value.([]LoggerArgument); ok && result[i].Key == groups[0] {
+			result[i].Value = appendGroupedLoggerArgs(group, groups[1:], newArgs)
+			return result
+		}
+	}
+
+	return append(result, LoggerArgument{
+		Key:   groups[0],
+		Value: appendGroupedLoggerArgs(nil, groups[1:], newArgs),
+	})
+}
+
+// slogStackTrace returns the stack trace, starting at the caller of the slog.Logger, which has the program counter.
+func slogStackTrace(pc uintptr) []string {
+	pcs := make([]uintptr, 64)
+	pcs = pcs[:runtime.Callers(2, pcs)]
+	for i := range pcs {
+		if pcs[i] == pc {
+			return stackTraceFromPCs(pcs[i:])
+		}
+	}
+	return nil
+}
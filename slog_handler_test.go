@@ -0,0 +1,109 @@
+package pterm_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+	"testing/slogtest"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func TestSlogHandler_Slogtest(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithFormatter(pterm.LogFormatterJSON).WithWriter(&buf).WithLevel(pterm.LogLevelTrace)
+
+	err := slogtest.TestHandler(pterm.NewSlogHandler(logger), func() []map[string]any {
+		var results []map[string]any
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			var m map[string]any
+			testza.AssertNoError(t, json.Unmarshal([]byte(line), &m))
+			// the JSON formatter writes the time of a record as timestamp
+			if timestamp, ok := m["timestamp"]; ok {
+				delete(m, "timestamp")
+				m[slog.TimeKey] = timestamp
+			}
+			results = append(results, m)
+		}
+		return results
+	})
+	testza.AssertNoError(t, err)
+}
+
+func TestSlogHandler_Groups(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithTime(false).WithMaxWidth(1000)
+
+	slog.New(pterm.NewSlogHandler(logger)).
+		With("a", 1).
+		WithGroup("request").
+		With("method", "GET").
+		Info("message", slog.Group("user", "id", 42), "path", "/")
+
+	testza.AssertEqual(t, "INFO  message a: 1 request.method: GET request.user.id: 42 request.path: /\n", pterm.RemoveColorFromString(buf.String()))
+}
+
+func TestSlogHandler_JSONKeepsAttributeOrder(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithFormatter(pterm.LogFormatterJSON).WithTime(false)
+
+	slog.New(pterm.NewSlogHandler(logger)).
+		With("zeta", 1).
+		WithGroup("request").
+		With("path", "/").
+		Info("message", "method", "GET", slog.Group("user", "name", "marvin", "id", 42), "body", "")
+
+	testza.AssertEqual(t, `{"level":"INFO","msg":"message","zeta":1,"request":{"path":"/","method":"GET","user":{"name":"marvin","id":42},"body":""}}`+"\n", buf.String())
+}
+
+func TestSlogHandler_WithAttrsAppends(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithTime(false).WithMaxWidth(1000)
+
+	slog.New(pterm.NewSlogHandler(logger)).With("a", 1).With("b", 2).Info("message", "c", 3)
+
+	testza.AssertEqual(t, "INFO  message a: 1 b: 2 c: 3\n", pterm.RemoveColorFromString(buf.String()))
+}
+
+func TestSlogHandler_CustomLevels(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithTime(false).WithLevel(pterm.LogLevelTrace)
+	l := slog.New(pterm.NewSlogHandler(logger))
+
+	l.Log(nil, slog.LevelDebug-4, "trace")
+	l.Log(nil, slog.LevelInfo+2, "info")
+	l.Log(nil, slog.LevelError+4, "error")
+
+	testza.AssertEqual(t, "TRACE trace \nINFO  info \nERROR error \n", pterm.RemoveColorFromString(buf.String()))
+}
+
+func TestSlogHandler_Enabled(t *testing.T) {
+	handler := pterm.NewSlogHandler(pterm.DefaultLogger.WithLevel(pterm.LogLevelWarn))
+
+	testza.AssertFalse(t, handler.Enabled(nil, slog.LevelInfo))
+	testza.AssertFalse(t, handler.Enabled(nil, slog.LevelInfo+2))
+	testza.AssertTrue(t, handler.Enabled(nil, slog.LevelWarn))
+	testza.AssertTrue(t, handler.Enabled(nil, slog.LevelError+4))
+}
+
+func TestSlogHandler_Caller(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pterm.DefaultLogger.WithWriter(&buf).WithTime(false).WithCaller()
+
+	slog.New(pterm.NewSlogHandler(logger)).Info("message")
+
+	testza.AssertContains(t, buf.String(), "slog_handler_test.go:")
+}
+
+func TestSlogLevelToLogLevel(t *testing.T) {
+	testza.AssertEqual(t, pterm.LogLevelTrace, pterm.SlogLevelToLogLevel(slog.LevelDebug-1))
+	testza.AssertEqual(t, pterm.LogLevelDebug, pterm.SlogLevelToLogLevel(slog.LevelDebug))
+	testza.AssertEqual(t, pterm.LogLevelInfo, pterm.SlogLevelToLogLevel(slog.LevelInfo))
+	testza.AssertEqual(t, pterm.LogLevelWarn, pterm.SlogLevelToLogLevel(slog.LevelWarn))
+	testza.AssertEqual(t, pterm.LogLevelError, pterm.SlogLevelToLogLevel(slog.LevelError))
+	testza.AssertEqual(t, pterm.LogLevelError, pterm.SlogLevelToLogLevel(slog.LevelError+8))
+}
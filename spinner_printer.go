@@ -2,12 +2,16 @@ package pterm
 
 import (
 	"io"
+	"sync"
 	"time"
 
 	"github.com/pterm/pterm/internal"
 )
 
-var activeSpinnerPrinters []*SpinnerPrinter
+var (
+	activeSpinnerPrinters   []*SpinnerPrinter
+	activeSpinnerPrintersMu sync.Mutex
+)
 
 // DefaultSpinner is the default SpinnerPrinter.
 var DefaultSpinner = SpinnerPrinter{
@@ -44,8 +48,12 @@ type SpinnerPrinter struct {
 
 	IsActive bool
 
+	mu              *sync.Mutex
+	stop            chan struct{}
+	done            chan struct{}
 	startedAt       time.Time
 	currentSequence string
+	liveOutput      string
 
 	Writer io.Writer
 }
@@ -62,6 +70,16 @@ func (s SpinnerPrinter) WithSequence(sequence ...string) *SpinnerPrinter {
 	return &s
 }
 
+// WithSequenceName sets the sequence of the SpinnerPrinter to a sequence of the SpinnerSequences catalog, like "dots" or "clock".
+// If the catalog doesn't contain the name, the sequence is not changed.
+// The sequence is copied, so changing the sequence of the SpinnerPrinter doesn't change the catalog.
+func (s SpinnerPrinter) WithSequenceName(name string) *SpinnerPrinter {
+	if sequence, ok := SpinnerSequences[name]; ok {
+		s.Sequence = append([]string(nil), sequence...)
+	}
+	return &s
+}
+
 // WithStyle adds a style to the SpinnerPrinter.
 func (s SpinnerPrinter) WithStyle(style *Style) *SpinnerPrinter {
 	s.Style = style
@@ -118,9 +136,12 @@ func (p *SpinnerPrinter) SetWriter(writer io.Writer) {
 // UpdateText updates the message of the active SpinnerPrinter.
 // Can be used live.
 func (s *SpinnerPrinter) UpdateText(text string) {
+	unlock := s.lock()
+	defer unlock()
+
 	s.Text = text
 	if !RawOutput {
-		Fprinto(s.Writer, s.Style.Sprint(s.currentSequence)+" "+s.MessageStyle.Sprint(s.Text))
+		fprintLive(s.Writer, &s.liveOutput, s.Style.Sprint(s.currentSequence)+" "+s.MessageStyle.Sprint(s.Text))
 	} else {
 		Fprintln(s.Writer, s.Text)
 	}
@@ -128,9 +149,21 @@ func (s *SpinnerPrinter) UpdateText(text string) {
 
 // Start the SpinnerPrinter.
 func (s SpinnerPrinter) Start(text ...interface{}) (*SpinnerPrinter, error) {
+	s.mu = &sync.Mutex{}
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
 	s.IsActive = true
 	s.startedAt = time.Now()
-	activeSpinnerPrinters = append(activeSpinnerPrinters, &s)
+
+	if s.Style == nil {
+		s.Style = NewStyle()
+	}
+	if s.MessageStyle == nil {
+		s.MessageStyle = NewStyle()
+	}
+	if s.TimerStyle == nil {
+		s.TimerStyle = NewStyle()
+	}
 
 	if len(text) != 0 {
 		s.Text = Sprint(text...)
@@ -140,44 +173,151 @@ func (s SpinnerPrinter) Start(text ...interface{}) (*SpinnerPrinter, error) {
 		Fprintln(s.Writer, s.Text)
 	}
 
+	activeSpinnerPrintersMu.Lock()
+	activeSpinnerPrinters = append(activeSpinnerPrinters, &s)
+	activeSpinnerPrintersMu.Unlock()
+
+	delay := s.Delay
+	if delay <= 0 {
+		delay = DefaultSpinner.Delay
+	}
+
 	go func() {
-		for s.IsActive {
-			for _, seq := range s.Sequence {
-				if !s.IsActive {
-					continue
-				}
-				if RawOutput {
-					time.Sleep(s.Delay)
-					continue
-				}
-
-				var timer string
-				if s.ShowTimer {
-					timer = " (" + time.Since(s.startedAt).Round(s.TimerRoundingFactor).String() + ")"
-				}
-				Fprinto(s.Writer, s.Style.Sprint(seq)+" "+s.MessageStyle.Sprint(s.Text)+s.TimerStyle.Sprint(timer))
-				s.currentSequence = seq
-				time.Sleep(s.Delay)
+		defer close(s.done)
+
+		ticker := time.NewTicker(delay)
+		defer ticker.Stop()
+
+		for frame := 0; ; frame++ {
+			s.renderFrame(frame)
+
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
 			}
 		}
 	}()
 	return &s, nil
 }
 
+// renderFrame renders the sequence of the given frame of the animation.
+func (s *SpinnerPrinter) renderFrame(frame int) {
+	unlock := s.lock()
+	defer unlock()
+
+	if !s.IsActive {
+		return
+	}
+	if len(s.Sequence) != 0 {
+		s.currentSequence = s.Sequence[frame%len(s.Sequence)]
+	}
+	if RawOutput {
+		return
+	}
+
+	var timer string
+	if s.ShowTimer {
+		timer = " (" + time.Since(s.startedAt).Round(s.TimerRoundingFactor).String() + ")"
+	}
+	fprintLive(s.Writer, &s.liveOutput, s.Style.Sprint(s.currentSequence)+" "+s.MessageStyle.Sprint(s.Text)+s.TimerStyle.Sprint(timer))
+}
+
 // Stop terminates the SpinnerPrinter immediately.
 // The SpinnerPrinter will not resolve into anything.
 func (s *SpinnerPrinter) Stop() error {
+	if s.stopAnimation() {
+		s.printDone()
+	}
+	return nil
+}
+
+// stopAnimation stops the animation and waits until its last frame is rendered.
+// It returns false, if the SpinnerPrinter was not active.
+func (s *SpinnerPrinter) stopAnimation() bool {
+	unlock := s.lock()
 	if !s.IsActive {
-		return nil
+		unlock()
+		return false
 	}
 	s.IsActive = false
+	unlock()
+
+	// the lock is released before waiting, as the animation needs it to render its current frame
+	if s.stop != nil {
+		close(s.stop)
+		<-s.done
+	}
+	removeActiveSpinnerPrinter(s)
+
+	return true
+}
+
+// printDone finishes the line of the stopped SpinnerPrinter, or removes it, if RemoveWhenDone is set.
+func (s *SpinnerPrinter) printDone() {
 	if s.RemoveWhenDone {
 		fClearLine(s.Writer)
 		Fprinto(s.Writer)
 	} else {
 		Fprintln(s.Writer)
 	}
-	return nil
+}
+
+// finish stops the SpinnerPrinter and replaces it with a message of the given TextPrinter.
+// If no message is given, the text of the SpinnerPrinter is used.
+func (s *SpinnerPrinter) finish(printer TextPrinter, message []interface{}) {
+	if len(message) == 0 {
+		unlock := s.lock()
+		message = []interface{}{s.Text}
+		unlock()
+	}
+
+	// the animation is stopped first, so that it can't overwrite the message
+	wasActive := s.stopAnimation()
+	fClearLine(s.Writer)
+	Fprinto(s.Writer, printer.Sprint(message...))
+	if wasActive {
+		s.printDone()
+	}
+}
+
+// lock locks the SpinnerPrinter and returns a function to unlock it.
+// A SpinnerPrinter, which was never started, is not locked.
+func (s *SpinnerPrinter) lock() func() {
+	if s.mu == nil {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+// isActive returns true if the SpinnerPrinter is running.
+func (s *SpinnerPrinter) isActive() bool {
+	unlock := s.lock()
+	defer unlock()
+
+	return s.IsActive
+}
+
+// removeActiveSpinnerPrinter removes a stopped SpinnerPrinter from the active spinners.
+func removeActiveSpinnerPrinter(s *SpinnerPrinter) {
+	activeSpinnerPrintersMu.Lock()
+	defer activeSpinnerPrintersMu.Unlock()
+
+	for i, spinner := range activeSpinnerPrinters {
+		if spinner == s {
+			activeSpinnerPrinters = append(activeSpinnerPrinters[:i:i], activeSpinnerPrinters[i+1:]...)
+			return
+		}
+	}
+}
+
+// activeSpinners returns a copy of the active spinners, which can be used without holding the lock.
+func activeSpinners() []*SpinnerPrinter {
+	activeSpinnerPrintersMu.Lock()
+	defer activeSpinnerPrintersMu.Unlock()
+
+	return append([]*SpinnerPrinter{}, activeSpinnerPrinters...)
 }
 
 // GenericStart runs Start, but returns a LivePrinter.
@@ -205,12 +345,7 @@ func (s *SpinnerPrinter) Info(message ...interface{}) {
 		s.InfoPrinter = &Info
 	}
 
-	if len(message) == 0 {
-		message = []interface{}{s.Text}
-	}
-	fClearLine(s.Writer)
-	Fprinto(s.Writer, s.InfoPrinter.Sprint(message...))
-	_ = s.Stop()
+	s.finish(s.InfoPrinter, message)
 }
 
 // Success displays the success printer.
@@ -220,12 +355,7 @@ func (s *SpinnerPrinter) Success(message ...interface{}) {
 		s.SuccessPrinter = &Success
 	}
 
-	if len(message) == 0 {
-		message = []interface{}{s.Text}
-	}
-	fClearLine(s.Writer)
-	Fprinto(s.Writer, s.SuccessPrinter.Sprint(message...))
-	_ = s.Stop()
+	s.finish(s.SuccessPrinter, message)
 }
 
 // Fail displays the fail printer.
@@ -235,12 +365,7 @@ func (s *SpinnerPrinter) Fail(message ...interface{}) {
 		s.FailPrinter = &Error
 	}
 
-	if len(message) == 0 {
-		message = []interface{}{s.Text}
-	}
-	fClearLine(s.Writer)
-	Fprinto(s.Writer, s.FailPrinter.Sprint(message...))
-	_ = s.Stop()
+	s.finish(s.FailPrinter, message)
 }
 
 // Warning displays the warning printer.
@@ -250,10 +375,5 @@ func (s *SpinnerPrinter) Warning(message ...interface{}) {
 		s.WarningPrinter = &Warning
 	}
 
-	if len(message) == 0 {
-		message = []interface{}{s.Text}
-	}
-	fClearLine(s.Writer)
-	Fprinto(s.Writer, s.WarningPrinter.Sprint(message...))
-	_ = s.Stop()
+	s.finish(s.WarningPrinter, message)
 }
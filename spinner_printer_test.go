@@ -1,8 +1,12 @@
 package pterm_test
 
 import (
+	"bytes"
+	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -271,3 +275,65 @@ func TestSpinnerPrinter_OutputToWriters(t *testing.T) {
 // func TestClearActiveSpinners(t *testing.T) {
 // 	activeSpinnerPrinters = []*pterm.SpinnerPrinter{}
 // }
+
+func TestSpinnerPrinter_ConcurrentLifecycle(t *testing.T) {
+	proxyToDevNull()
+	defer teardownStdoutCapture()
+
+	s, _ := pterm.DefaultSpinner.WithDelay(time.Millisecond).Start("Starting")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			s.UpdateText(fmt.Sprint("Update ", i))
+		}
+	}()
+
+	time.Sleep(time.Millisecond * 20)
+	s.Success()
+	<-done
+
+	testza.AssertFalse(t, s.IsActive)
+	testza.AssertNoError(t, s.Stop())
+}
+
+func TestSpinnerPrinter_FinalMessageIsNotOverwritten(t *testing.T) {
+	var buf bytes.Buffer
+	s, _ := pterm.DefaultSpinner.WithDelay(time.Millisecond).WithWriter(&buf).Start("Working")
+	time.Sleep(time.Millisecond * 10)
+	s.Success("Finished")
+	output := buf.String()
+	time.Sleep(time.Millisecond * 10)
+
+	testza.AssertEqual(t, output, buf.String())
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\r")
+	testza.AssertContains(t, lines[len(lines)-1], "Finished")
+}
+
+func TestSpinnerPrinter_WithSequenceName(t *testing.T) {
+	for _, name := range pterm.SpinnerSequenceNames() {
+		p := pterm.DefaultSpinner.WithSequenceName(name)
+		testza.AssertEqual(t, pterm.SpinnerSequences[name], p.Sequence)
+	}
+
+	p := pterm.DefaultSpinner.WithSequenceName("does-not-exist")
+	testza.AssertEqual(t, pterm.DefaultSpinner.Sequence, p.Sequence)
+}
+
+func TestSpinnerPrinter_WithSequenceNameCopiesSequence(t *testing.T) {
+	original := pterm.SpinnerSequences["dots"][0]
+
+	p := pterm.DefaultSpinner.WithSequenceName("dots")
+	p.Sequence[0] = "changed"
+
+	testza.AssertEqual(t, original, pterm.SpinnerSequences["dots"][0])
+}
+
+func TestSpinnerSequenceNames(t *testing.T) {
+	names := pterm.SpinnerSequenceNames()
+
+	testza.AssertLen(t, names, len(pterm.SpinnerSequences))
+	testza.AssertContains(t, names, "dots")
+	testza.AssertTrue(t, sort.StringsAreSorted(names))
+}
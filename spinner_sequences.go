@@ -0,0 +1,42 @@
+package pterm
+
+import "sort"
+
+// SpinnerSequences is a catalog of named sequences, which can be used by a SpinnerPrinter.
+// Use SpinnerPrinter.WithSequenceName to select a sequence by its name.
+// Custom sequences can be added to the catalog.
+var SpinnerSequences = map[string][]string{
+	"default":         {"▀ ", " ▀", " ▄", "▄ "},
+	"dots":            {"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+	"line":            {"-", "\\", "|", "/"},
+	"pipe":            {"┤", "┘", "┴", "└", "├", "┌", "┬", "┐"},
+	"arc":             {"◜", "◠", "◝", "◞", "◡", "◟"},
+	"circle-quarters": {"◴", "◷", "◶", "◵"},
+	"square-corners":  {"◰", "◳", "◲", "◱"},
+	"triangle":        {"◢", "◣", "◤", "◥"},
+	"arrows":          {"←", "↖", "↑", "↗", "→", "↘", "↓", "↙"},
+	"star":            {"✶", "✸", "✹", "✺", "✹", "✷"},
+	"toggle":          {"⊶", "⊷"},
+	"grow-vertical":   {"▁", "▃", "▄", "▅", "▆", "▇", "▆", "▅", "▄", "▃"},
+	"grow-horizontal": {"▏", "▎", "▍", "▌", "▋", "▊", "▉", "▊", "▋", "▌", "▍", "▎"},
+	"bouncing-bar": {
+		"[    ]", "[=   ]", "[==  ]", "[=== ]", "[ ===]", "[  ==]", "[   =]",
+		"[    ]", "[   =]", "[  ==]", "[ ===]", "[====]", "[=== ]", "[==  ]", "[=   ]",
+	},
+	"bouncing-ball": {
+		"( ●    )", "(  ●   )", "(   ●  )", "(    ● )", "(     ●)",
+		"(    ● )", "(   ●  )", "(  ●   )", "( ●    )", "(●     )",
+	},
+	"clock": {"🕛", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚"},
+	"moon":  {"🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘"},
+}
+
+// SpinnerSequenceNames returns the sorted names of all sequences in SpinnerSequences.
+func SpinnerSequenceNames() []string {
+	names := make([]string, 0, len(SpinnerSequences))
+	for name := range SpinnerSequences {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -1,10 +1,22 @@
 package pterm
 
 import (
+	"bytes"
 	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
 	"io"
+	"os"
+	"sort"
+	"strconv"
 	"strings"
 
+	"atomicgo.dev/cursor"
+	"atomicgo.dev/keyboard"
+	"atomicgo.dev/keyboard/keys"
+	"golang.org/x/term"
+
 	"github.com/pterm/pterm/internal"
 )
 
@@ -18,6 +30,7 @@ var DefaultTable = TablePrinter{
 	SeparatorStyle:          &ThemeDefault.TableSeparatorStyle,
 	RowSeparator:            "",
 	RowSeparatorStyle:       &ThemeDefault.TableSeparatorStyle,
+	FooterStyle:             &ThemeDefault.TableFooterStyle,
 	LeftAlignment:           true,
 	RightAlignment:          false,
 }
@@ -25,6 +38,197 @@ var DefaultTable = TablePrinter{
 // TableData is the type that contains the data of a TablePrinter.
 type TableData [][]string
 
+// TableCell is a single cell of a TablePrinter.
+// A cell can span multiple columns and rows.
+type TableCell struct {
+	// Text is the content of the cell.
+	Text string
+	// ColSpan is the number of columns the cell spans. Values below 1 are treated as 1.
+	ColSpan int
+	// RowSpan is the number of rows the cell spans. Values below 1 are treated as 1.
+	RowSpan int
+	// Style is the style of the content of the cell. If nil, the content is not styled.
+	Style *Style
+}
+
+// TableCells is the type that contains the cells of a TablePrinter.
+// Positions which are covered by a cell spanning from a previous row are skipped,
+// so a row only contains the cells that start in it.
+type TableCells [][]TableCell
+
+// TableAlignment is the horizontal alignment of the content of a table column.
+type TableAlignment int
+
+const (
+	// TableAlignmentDefault uses the alignment of the TablePrinter (LeftAlignment or RightAlignment).
+	TableAlignmentDefault TableAlignment = iota
+	// TableAlignmentLeft aligns the content of a column to the left.
+	TableAlignmentLeft
+	// TableAlignmentCenter centers the content of a column.
+	TableAlignmentCenter
+	// TableAlignmentRight aligns the content of a column to the right.
+	TableAlignmentRight
+)
+
+// TableOverflow defines how content, which is wider than its column, is handled.
+type TableOverflow int
+
+const (
+	// TableOverflowWrap wraps the content between words.
+	// Columns with this mode are shrunk, if the table is wider than the MaxWidth of the TablePrinter.
+	TableOverflowWrap TableOverflow = iota
+	// TableOverflowTruncate cuts the content and appends an ellipsis.
+	TableOverflowTruncate
+)
+
+// TableColumn describes the layout of a single column of a TablePrinter.
+type TableColumn struct {
+	// Alignment is the alignment of the content inside the column.
+	Alignment TableAlignment
+	// MinWidth is the minimum width of the column.
+	MinWidth int
+	// MaxWidth is the maximum width of the column. Zero means that the width is unlimited.
+	MaxWidth int
+	// Overflow defines how content, which is wider than the column, is handled.
+	Overflow TableOverflow
+	// Aggregation is the value, which is shown for this column in the footer of the table.
+	// If any column has an aggregation, a footer row is rendered.
+	Aggregation TableAggregation
+}
+
+// TableSortMode defines how the values of a column are compared while sorting.
+type TableSortMode int
+
+const (
+	// TableSortString compares the values as strings.
+	TableSortString TableSortMode = iota
+	// TableSortNumeric compares the values as numbers. Values, which are not numbers, are sorted to the end.
+	TableSortNumeric
+	// TableSortNatural compares the values in natural order, so "file2" comes before "file10".
+	TableSortNatural
+)
+
+// TableSort defines by which column the rows of a table are sorted.
+// The header and the footer of the table are never sorted.
+type TableSort struct {
+	// Column is the index of the column, by which the rows are sorted.
+	Column int
+	// Mode defines how the values of the column are compared.
+	Mode TableSortMode
+	// Descending sorts the rows in descending instead of ascending order.
+	Descending bool
+}
+
+// TableAggregation is a value, which is calculated from the numeric values of a column and shown in the footer of a table.
+type TableAggregation int
+
+const (
+	// TableAggregationNone shows nothing in the footer.
+	TableAggregationNone TableAggregation = iota
+	// TableAggregationSum shows the sum of all numeric values.
+	TableAggregationSum
+	// TableAggregationAvg shows the average of all numeric values.
+	TableAggregationAvg
+	// TableAggregationCount shows the number of non-empty values.
+	TableAggregationCount
+	// TableAggregationMin shows the smallest numeric value.
+	TableAggregationMin
+	// TableAggregationMax shows the biggest numeric value.
+	TableAggregationMax
+)
+
+// TableBorderStyle contains the strings, which are used to draw the grid of a TablePrinter.
+// The junctions are used where lines meet, e.g. TopJunction where a column separator meets the top border.
+type TableBorderStyle struct {
+	Horizontal     string
+	Vertical       string
+	TopLeft        string
+	TopRight       string
+	BottomLeft     string
+	BottomRight    string
+	TopJunction    string
+	BottomJunction string
+	LeftJunction   string
+	RightJunction  string
+	Cross          string
+	// OmitTopAndBottom omits the top and bottom border of the grid.
+	OmitTopAndBottom bool
+}
+
+var (
+	// TableBorderSingle draws the grid with single lines.
+	TableBorderSingle = TableBorderStyle{
+		Horizontal: "─", Vertical: "│",
+		TopLeft: "┌", TopRight: "┐", BottomLeft: "└", BottomRight: "┘",
+		TopJunction: "┬", BottomJunction: "┴", LeftJunction: "├", RightJunction: "┤", Cross: "┼",
+	}
+
+	// TableBorderDouble draws the grid with double lines.
+	TableBorderDouble = TableBorderStyle{
+		Horizontal: "═", Vertical: "║",
+		TopLeft: "╔", TopRight: "╗", BottomLeft: "╚", BottomRight: "╝",
+		TopJunction: "╦", BottomJunction: "╩", LeftJunction: "╠", RightJunction: "╣", Cross: "╬",
+	}
+
+	// TableBorderRounded draws the grid with single lines and rounded corners.
+	TableBorderRounded = TableBorderStyle{
+		Horizontal: "─", Vertical: "│",
+		TopLeft: "╭", TopRight: "╮", BottomLeft: "╰", BottomRight: "╯",
+		TopJunction: "┬", BottomJunction: "┴", LeftJunction: "├", RightJunction: "┤", Cross: "┼",
+	}
+
+	// TableBorderHeavy draws the grid with heavy lines.
+	TableBorderHeavy = TableBorderStyle{
+		Horizontal: "━", Vertical: "┃",
+		TopLeft: "┏", TopRight: "┓", BottomLeft: "┗", BottomRight: "┛",
+		TopJunction: "┳", BottomJunction: "┻", LeftJunction: "┣", RightJunction: "┫", Cross: "╋",
+	}
+
+	// TableBorderASCII draws the grid with ASCII characters only.
+	TableBorderASCII = TableBorderStyle{
+		Horizontal: "-", Vertical: "|",
+		TopLeft: "+", TopRight: "+", BottomLeft: "+", BottomRight: "+",
+		TopJunction: "+", BottomJunction: "+", LeftJunction: "+", RightJunction: "+", Cross: "+",
+	}
+
+	// TableBorderMarkdown draws the grid like a markdown table, without a top and bottom border.
+	TableBorderMarkdown = TableBorderStyle{
+		Horizontal: "-", Vertical: "|",
+		TopLeft: "|", TopRight: "|", BottomLeft: "|", BottomRight: "|",
+		TopJunction: "|", BottomJunction: "|", LeftJunction: "|", RightJunction: "|", Cross: "|",
+		OmitTopAndBottom: true,
+	}
+)
+
+// junction returns the string for a point of the grid, where lines in the given directions meet.
+func (b TableBorderStyle) junction(up, down, left, right bool) string {
+	switch {
+	case up && down && left && right:
+		return b.Cross
+	case down && left && right:
+		return b.TopJunction
+	case up && left && right:
+		return b.BottomJunction
+	case up && down && right:
+		return b.LeftJunction
+	case up && down && left:
+		return b.RightJunction
+	case down && right:
+		return b.TopLeft
+	case down && left:
+		return b.TopRight
+	case up && right:
+		return b.BottomLeft
+	case up && left:
+		return b.BottomRight
+	case left || right:
+		return b.Horizontal
+	case up || down:
+		return b.Vertical
+	}
+	return strings.Repeat(" ", internal.GetStringMaxWidth(b.Vertical))
+}
+
 // TablePrinter is able to render tables.
 type TablePrinter struct {
 	Style                   *Style
@@ -37,9 +241,18 @@ type TablePrinter struct {
 	RowSeparator            string
 	RowSeparatorStyle       *Style
 	Data                    TableData
+	Cells                   TableCells
 	Boxed                   bool
 	LeftAlignment           bool
 	RightAlignment          bool
+	Columns                 []TableColumn
+	BorderStyle             *TableBorderStyle
+	MaxWidth                int
+	Pager                   bool
+	Sort                    *TableSort
+	Filter                  func(row []string) bool
+	FooterLabel             string
+	FooterStyle             *Style
 	Writer                  io.Writer
 }
 
@@ -103,6 +316,13 @@ func (p TablePrinter) WithData(data [][]string) *TablePrinter {
 	return &p
 }
 
+// WithCells returns a new TablePrinter with specific Cells.
+// If Cells are set, they are rendered instead of the Data.
+func (p TablePrinter) WithCells(cells TableCells) *TablePrinter {
+	p.Cells = cells
+	return &p
+}
+
 // WithCSVReader return a new TablePrinter with specified Data extracted from CSV.
 func (p TablePrinter) WithCSVReader(reader *csv.Reader) *TablePrinter {
 	if records, err := reader.ReadAll(); err == nil {
@@ -133,6 +353,65 @@ func (p TablePrinter) WithRightAlignment(b ...bool) *TablePrinter {
 	return &p
 }
 
+// WithColumns returns a new TablePrinter with specific Columns.
+// The n-th TableColumn describes the n-th column of the table.
+func (p TablePrinter) WithColumns(columns []TableColumn) *TablePrinter {
+	p.Columns = columns
+	return &p
+}
+
+// WithBorderStyle returns a new TablePrinter with a specific BorderStyle.
+// If a BorderStyle is set, the table is drawn as a grid and Boxed is ignored.
+func (p TablePrinter) WithBorderStyle(style *TableBorderStyle) *TablePrinter {
+	p.BorderStyle = style
+	return &p
+}
+
+// WithMaxWidth returns a new TablePrinter with a specific MaxWidth.
+// Columns using TableOverflowWrap are shrunk, until the table fits into MaxWidth.
+// If MaxWidth is zero, the width of the terminal is used, but only columns with a TableColumn are shrunk,
+// so that tables without Columns are never wrapped.
+func (p TablePrinter) WithMaxWidth(width int) *TablePrinter {
+	p.MaxWidth = width
+	return &p
+}
+
+// WithPager returns a new TablePrinter, which is rendered in an interactive pager, if it is taller than the terminal.
+// The pager can be scrolled with the arrow keys, PgUp, PgDown, Home and End, and closed with q, Esc or Enter.
+// If the standard input or the Writer is not a terminal, the whole table is printed instead.
+func (p TablePrinter) WithPager(b ...bool) *TablePrinter {
+	p.Pager = internal.WithBoolean(b)
+	return &p
+}
+
+// WithSort returns a new TablePrinter, which sorts its rows by a column.
+// Rows, which are connected by cells spanning multiple rows, stay together and are sorted by their first row.
+func (p TablePrinter) WithSort(sort TableSort) *TablePrinter {
+	p.Sort = &sort
+	return &p
+}
+
+// WithFilter returns a new TablePrinter, which only renders the rows for which filter returns true.
+// The header and the footer of the table are never filtered.
+// Rows, which are connected by cells spanning multiple rows, are kept or removed together, depending on their first row.
+func (p TablePrinter) WithFilter(filter func(row []string) bool) *TablePrinter {
+	p.Filter = filter
+	return &p
+}
+
+// WithFooterLabel returns a new TablePrinter with a specific FooterLabel.
+// The label is shown in the first column of the footer, if that column has no aggregation.
+func (p TablePrinter) WithFooterLabel(label string) *TablePrinter {
+	p.FooterLabel = label
+	return &p
+}
+
+// WithFooterStyle returns a new TablePrinter with a specific FooterStyle.
+func (p TablePrinter) WithFooterStyle(style *Style) *TablePrinter {
+	p.FooterStyle = style
+	return &p
+}
+
 // WithWriter sets the Writer.
 func (p TablePrinter) WithWriter(writer io.Writer) *TablePrinter {
 	p.Writer = writer
@@ -141,23 +420,46 @@ func (p TablePrinter) WithWriter(writer io.Writer) *TablePrinter {
 
 type table struct {
 	rows            []row
+	cells           []cell
+	grid            [][]int
 	maxColumnWidths []int
 }
 
 type row struct {
-	height       int
-	cells        []cell
-	columnWidths []int
+	height int
 }
 
 type cell struct {
-	width  int
-	height int
-	lines  []string
+	row     int
+	column  int
+	rowSpan int
+	colSpan int
+	width   int
+	height  int
+	lines   []string
+	style   *Style
 }
 
 // Srender renders the TablePrinter as a string.
 func (p TablePrinter) Srender() (string, error) {
+	p = p.withDefaultStyles()
+	t := p.newTable()
+
+	if p.BorderStyle != nil {
+		return p.renderGrid(t), nil
+	}
+
+	s := p.renderPlain(t)
+
+	if p.Boxed {
+		s = DefaultBox.Sprint(strings.TrimSuffix(s, "\n"))
+	}
+
+	return s, nil
+}
+
+// withDefaultStyles returns a copy of the TablePrinter, where all unset styles are replaced by empty styles.
+func (p TablePrinter) withDefaultStyles() TablePrinter {
 	if p.Style == nil {
 		p.Style = NewStyle()
 	}
@@ -173,105 +475,683 @@ func (p TablePrinter) Srender() (string, error) {
 	if p.RowSeparatorStyle == nil {
 		p.RowSeparatorStyle = NewStyle()
 	}
+	if p.FooterStyle == nil {
+		p.FooterStyle = NewStyle()
+	}
+	return p
+}
+
+// renderPlain renders the table with the Separator between columns and repeated separators between rows.
+func (p TablePrinter) renderPlain(t table) string {
+	var maxRowWidth int
+	for i := range t.rows {
+		rowWidth := internal.GetStringMaxWidth(p.renderRow(t, i))
+		if rowWidth > maxRowWidth {
+			maxRowWidth = rowWidth
+		}
+	}
+
+	// render table
+	var s string
+
+	headerRows := t.headerRows(p.HasHeader)
+	footerRow := t.footerRow(p.hasFooter())
+	for i := range t.rows {
+		if i < headerRows {
+			s += p.HeaderStyle.Sprint(p.renderRow(t, i))
+
+			if i == headerRows-1 && p.HeaderRowSeparator != "" {
+				s += strings.Repeat(p.HeaderRowSeparatorStyle.Sprint(p.HeaderRowSeparator), maxRowWidth) + "\n"
+			}
+			continue
+		}
+
+		if i == footerRow {
+			if p.HeaderRowSeparator != "" && p.RowSeparator == "" {
+				s += strings.Repeat(p.HeaderRowSeparatorStyle.Sprint(p.HeaderRowSeparator), maxRowWidth) + "\n"
+			}
+			s += p.FooterStyle.Sprint(p.renderRow(t, i))
+			continue
+		}
+
+		s += p.renderRow(t, i)
+
+		if p.RowSeparator != "" {
+			s += strings.Repeat(p.RowSeparatorStyle.Sprint(p.RowSeparator), maxRowWidth) + "\n"
+		}
+	}
+
+	return s
+}
+
+// tableCells returns the Cells of the TablePrinter.
+// If no Cells are set, the Data is converted into cells.
+// The rows are filtered and sorted, and the footer is appended, if the TablePrinter is configured to do so.
+func (p TablePrinter) tableCells() TableCells {
+	cells := p.Cells
+	if cells == nil {
+		cells = make(TableCells, len(p.Data))
+		for i, rRaw := range p.Data {
+			cells[i] = make([]TableCell, len(rRaw))
+			for j, cRaw := range rRaw {
+				cells[i][j] = TableCell{Text: cRaw}
+			}
+		}
+	}
+
+	if p.Filter == nil && p.Sort == nil && !p.hasFooter() {
+		return cells
+	}
+
+	// the header consists of the first row and all rows that cells of the first row span into
+	var headerRows int
+	if p.HasHeader && len(cells) > 0 {
+		headerRows = 1
+		for _, c := range cells[0] {
+			headerRows = max(headerRows, c.RowSpan)
+		}
+		headerRows = min(headerRows, len(cells))
+	}
+
+	// rows, which are connected by cells spanning multiple rows, are filtered and sorted by their first row
+	var groups []TableCells
+	for _, group := range tableRowGroups(cells[headerRows:]) {
+		if p.Filter == nil || p.Filter(tableRowValues(group[0])) {
+			groups = append(groups, group)
+		}
+	}
+
+	if p.Sort != nil {
+		sort.SliceStable(groups, func(i, j int) bool {
+			a, b := tableRowValue(groups[i][0], p.Sort.Column), tableRowValue(groups[j][0], p.Sort.Column)
+			return tableValueLess(a, b, p.Sort.Mode, p.Sort.Descending)
+		})
+	}
+
+	body := make(TableCells, 0, len(cells)-headerRows)
+	for _, group := range groups {
+		body = append(body, group...)
+	}
+
+	ret := append(append(TableCells{}, cells[:headerRows]...), body...)
+	if p.hasFooter() {
+		ret = append(ret, p.footerRow(body))
+	}
+
+	return ret
+}
+
+// tableRowGroups splits the rows into groups of consecutive rows, which are connected by cells spanning multiple rows.
+// Rows without such cells form a group on their own.
+func tableRowGroups(rows TableCells) []TableCells {
+	var groups []TableCells
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for r := start; r < end && r < len(rows); r++ {
+			for _, c := range rows[r] {
+				end = max(end, r+c.RowSpan)
+			}
+		}
+		end = min(end, len(rows))
+
+		groups = append(groups, rows[start:end])
+		start = end
+	}
+	return groups
+}
+
+// hasFooter returns true, if any column has an aggregation, which is shown in the footer.
+func (p TablePrinter) hasFooter() bool {
+	for _, column := range p.Columns {
+		if column.Aggregation != TableAggregationNone {
+			return true
+		}
+	}
+	return false
+}
+
+// footerRow returns the footer of the table, containing the aggregations of all columns.
+func (p TablePrinter) footerRow(body TableCells) []TableCell {
+	columns := len(p.Columns)
+	for _, r := range body {
+		columns = max(columns, len(tableRowValues(r)))
+	}
+
+	footer := make([]TableCell, columns)
+	for i := range footer {
+		aggregation := p.column(i).Aggregation
+		if i == 0 && aggregation == TableAggregationNone {
+			footer[i].Text = p.FooterLabel
+			continue
+		}
+
+		var values []float64
+		var count int
+		for _, r := range body {
+			value := tableRowValue(r, i)
+			if value != "" {
+				count++
+			}
+			if number, ok := parseTableNumber(value); ok {
+				values = append(values, number)
+			}
+		}
+
+		switch aggregation {
+		case TableAggregationCount:
+			footer[i].Text = Sprint(count)
+		case TableAggregationSum, TableAggregationAvg:
+			var sum float64
+			for _, v := range values {
+				sum += v
+			}
+			if aggregation == TableAggregationAvg {
+				if len(values) == 0 {
+					continue
+				}
+				sum /= float64(len(values))
+			}
+			footer[i].Text = formatTableNumber(sum)
+		case TableAggregationMin, TableAggregationMax:
+			if len(values) == 0 {
+				continue
+			}
+			result := values[0]
+			for _, v := range values[1:] {
+				if (aggregation == TableAggregationMin) == (v < result) {
+					result = v
+				}
+			}
+			footer[i].Text = formatTableNumber(result)
+		}
+	}
+
+	return footer
+}
+
+// tableRowValues returns the texts of a row, indexed by column.
+// Columns covered by a cell spanning multiple columns are empty.
+func tableRowValues(r []TableCell) []string {
+	var values []string
+	for _, c := range r {
+		values = append(values, c.Text)
+		for i := 1; i < c.ColSpan; i++ {
+			values = append(values, "")
+		}
+	}
+	return values
+}
+
+// tableRowValue returns the text of a row in the column with the index column, without colors.
+func tableRowValue(r []TableCell, column int) string {
+	values := tableRowValues(r)
+	if column < 0 || column >= len(values) {
+		return ""
+	}
+	return RemoveColorFromString(values[column])
+}
+
+// tableValueLess reports whether the value a is sorted before the value b.
+// In numeric mode, values which are not numbers are sorted to the end, even if the order is descending.
+func tableValueLess(a, b string, mode TableSortMode, descending bool) bool {
+	if mode == TableSortNumeric {
+		numberA, okA := parseTableNumber(a)
+		numberB, okB := parseTableNumber(b)
+		switch {
+		case okA != okB:
+			return okA
+		case !okA:
+			return false
+		case descending:
+			return numberB < numberA
+		}
+		return numberA < numberB
+	}
+
+	if descending {
+		a, b = b, a
+	}
+	if mode == TableSortNatural {
+		return internal.NaturalLess(a, b)
+	}
+	return a < b
+}
+
+// parseTableNumber parses the value of a cell as a number.
+func parseTableNumber(s string) (float64, bool) {
+	number, err := strconv.ParseFloat(strings.TrimSpace(RemoveColorFromString(s)), 64)
+	return number, err == nil
+}
+
+// formatTableNumber formats a calculated number with at most two decimal places.
+func formatTableNumber(number float64) string {
+	s := strconv.FormatFloat(number, 'f', 2, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
 
+// newTable lays out the cells of the TablePrinter in a grid and calculates the size of every row and column.
+func (p TablePrinter) newTable() table {
+	t := p.placeCells()
+
+	// set max column widths of table
+	for _, c := range t.cells {
+		if c.colSpan == 1 && c.width > t.maxColumnWidths[c.column] {
+			t.maxColumnWidths[c.column] = c.width
+		}
+	}
+
+	// widen the columns of cells spanning multiple columns, if their content does not fit
+	for _, c := range t.cells {
+		if missing := c.width - p.spanWidth(t, c); c.colSpan > 1 && missing > 0 {
+			for col := c.column; col < c.column+c.colSpan; col++ {
+				t.maxColumnWidths[col] += missing / c.colSpan
+				if col-c.column < missing%c.colSpan {
+					t.maxColumnWidths[col]++
+				}
+			}
+		}
+	}
+
+	p.applyColumnWidths(&t)
+	p.fitCells(&t)
+
+	return t
+}
+
+// fitCells wraps or truncates the cells of the table to the width of their columns and calculates the row heights.
+func (p TablePrinter) fitCells(t *table) {
+	// wrap or truncate cells, which are wider than their column, and calculate row heights
+	for i, c := range t.cells {
+		column := p.column(c.column)
+		width := p.spanWidth(*t, c)
+		var lines []string
+		for _, l := range c.lines {
+			if column.Overflow == TableOverflowTruncate {
+				lines = append(lines, internal.TruncateText(l, width, "…"))
+			} else {
+				lines = append(lines, internal.WrapText(l, width)...)
+			}
+		}
+		c.lines = lines
+		c.height = len(lines)
+		t.cells[i] = c
+
+		if c.rowSpan == 1 && c.height > t.rows[c.row].height {
+			t.rows[c.row].height = c.height
+		}
+	}
+
+	// enlarge the last row of cells spanning multiple rows, if their content does not fit
+	for _, c := range t.cells {
+		var height int
+		for r := c.row; r < c.row+c.rowSpan; r++ {
+			height += t.rows[r].height
+		}
+		if c.height > height {
+			t.rows[c.row+c.rowSpan-1].height += c.height - height
+		}
+	}
+}
+
+// placeCells places the cells of the TablePrinter in a grid, without calculating the size of rows and columns.
+func (p TablePrinter) placeCells() table {
 	var t table
 
-	// convert data to table and calculate values
-	for _, rRaw := range p.Data {
-		var r row
+	// place cells in the grid, skipping positions which are occupied by cells spanning from previous rows
+	for i, rRaw := range p.tableCells() {
+		t.growGrid(i + 1)
+		column := 0
 		for _, cRaw := range rRaw {
-			var c cell
-			c.lines = strings.Split(cRaw, "\n")
-			c.height = len(c.lines)
+			for column < len(t.grid[i]) && t.grid[i][column] != -1 {
+				column++
+			}
+
+			c := cell{
+				row:     i,
+				column:  column,
+				rowSpan: max(cRaw.RowSpan, 1),
+				colSpan: max(cRaw.ColSpan, 1),
+				lines:   strings.Split(cRaw.Text, "\n"),
+				style:   cRaw.Style,
+			}
 			for _, l := range c.lines {
 				if maxWidth := internal.GetStringMaxWidth(l); maxWidth > c.width {
 					c.width = maxWidth
 				}
 			}
-			r.cells = append(r.cells, c)
-			if c.height > r.height {
-				r.height = c.height
-			}
-		}
 
-		// set max column widths of table
-		for i, c := range r.cells {
-			if len(t.maxColumnWidths) <= i {
-				t.maxColumnWidths = append(t.maxColumnWidths, c.width)
-			} else if c.width > t.maxColumnWidths[i] {
-				t.maxColumnWidths[i] = c.width
+			t.growGrid(i + c.rowSpan)
+			for r := i; r < i+c.rowSpan; r++ {
+				for len(t.grid[r]) < column+c.colSpan {
+					t.grid[r] = append(t.grid[r], -1)
+				}
+				for col := column; col < column+c.colSpan; col++ {
+					t.grid[r][col] = len(t.cells)
+				}
 			}
+			t.cells = append(t.cells, c)
+			column += c.colSpan
 		}
+	}
+	t.rows = make([]row, len(t.grid))
 
-		t.rows = append(t.rows, r)
+	columns := 0
+	for _, c := range t.cells {
+		columns = max(columns, c.column+c.colSpan)
 	}
+	t.maxColumnWidths = make([]int, columns)
 
-	var maxRowWidth int
-	for _, r := range t.rows {
-		rowWidth := internal.GetStringMaxWidth(p.renderRow(t, r))
-		if rowWidth > maxRowWidth {
-			maxRowWidth = rowWidth
-		}
+	return t
+}
+
+// growGrid adds empty rows to the grid, until it has at least n rows.
+func (t *table) growGrid(n int) {
+	for len(t.grid) < n {
+		t.grid = append(t.grid, nil)
 	}
+}
 
-	// render table
-	var s string
+// cellAt returns the cell which covers the column in the row r.
+// If no cell covers the position, an empty cell is returned.
+func (t table) cellAt(r, column int) cell {
+	if index := t.cellIndex(r, column); index != -1 {
+		return t.cells[index]
+	}
+	return cell{row: r, column: column, rowSpan: 1, colSpan: 1}
+}
 
-	for i, r := range t.rows {
-		if i == 0 && p.HasHeader {
-			s += p.HeaderStyle.Sprint(p.renderRow(t, r))
+// cellIndex returns the index of the cell which covers the column in the row r, or -1.
+func (t table) cellIndex(r, column int) int {
+	if column >= len(t.grid[r]) {
+		return -1
+	}
+	return t.grid[r][column]
+}
 
-			if p.HeaderRowSeparator != "" {
-				s += strings.Repeat(p.HeaderRowSeparatorStyle.Sprint(p.HeaderRowSeparator), maxRowWidth) + "\n"
-			}
-			continue
+// cellID returns a value, which is equal for all positions covered by the same cell.
+// Positions which are not covered by a cell get a unique negative value.
+func (t table) cellID(r, column int) int {
+	if index := t.cellIndex(r, column); index != -1 {
+		return index
+	}
+	return -2 - r*len(t.maxColumnWidths) - column
+}
+
+// headerRows returns the number of rows which belong to the header.
+// The header consists of the first row and all rows that cells of the first row span into.
+func (t table) headerRows(hasHeader bool) int {
+	if !hasHeader || len(t.rows) == 0 {
+		return 0
+	}
+
+	headerRows := 1
+	for _, c := range t.cells {
+		if c.row == 0 && c.rowSpan > headerRows {
+			headerRows = c.rowSpan
 		}
+	}
 
-		s += p.renderRow(t, r)
+	return headerRows
+}
 
-		if p.RowSeparator != "" {
-			s += strings.Repeat(p.RowSeparatorStyle.Sprint(p.RowSeparator), maxRowWidth) + "\n"
+// footerRow returns the index of the footer row, or -1 if the table has no footer.
+// The footer is always the last row of the table.
+func (t table) footerRow(hasFooter bool) int {
+	if !hasFooter || len(t.rows) == 0 {
+		return -1
+	}
+	return len(t.rows) - 1
+}
+
+// spanWidth returns the width of a cell, including the separators between the columns it spans.
+func (p TablePrinter) spanWidth(t table, c cell) int {
+	width := p.separatorWidth() * (c.colSpan - 1)
+	for col := c.column; col < c.column+c.colSpan; col++ {
+		width += t.maxColumnWidths[col]
+	}
+	return width
+}
+
+// separatorWidth returns the width of the separator between two columns.
+func (p TablePrinter) separatorWidth() int {
+	if p.BorderStyle != nil {
+		return internal.GetStringMaxWidth(p.BorderStyle.Vertical) + 2
+	}
+	return internal.GetStringMaxWidth(p.Separator)
+}
+
+// column returns the TableColumn of the column with the index i.
+// If no TableColumn is set for the column, an empty TableColumn is returned.
+func (p TablePrinter) column(i int) TableColumn {
+	if i < len(p.Columns) {
+		return p.Columns[i]
+	}
+	return TableColumn{}
+}
+
+// columnAlignment returns the alignment of the column with the index i.
+// It falls back to the global alignment of the TablePrinter.
+func (p TablePrinter) columnAlignment(i int) TableAlignment {
+	if alignment := p.column(i).Alignment; alignment != TableAlignmentDefault {
+		return alignment
+	}
+	switch {
+	case p.RightAlignment:
+		return TableAlignmentRight
+	case p.LeftAlignment:
+		return TableAlignmentLeft
+	}
+	return TableAlignmentDefault
+}
+
+// applyColumnWidths applies the MinWidth and MaxWidth of every TableColumn to the column widths of the table.
+// Afterwards, the widest columns which use TableOverflowWrap are shrunk, until the table fits into the MaxWidth of the TablePrinter.
+// Without a MaxWidth, only columns with a TableColumn are shrunk to the terminal width.
+func (p TablePrinter) applyColumnWidths(t *table) {
+	for i, width := range t.maxColumnWidths {
+		column := p.column(i)
+		if width < column.MinWidth {
+			width = column.MinWidth
+		}
+		if column.MaxWidth > 0 && width > column.MaxWidth {
+			width = column.MaxWidth
 		}
+		t.maxColumnWidths[i] = width
 	}
 
-	if p.Boxed {
-		s = DefaultBox.Sprint(strings.TrimSuffix(s, "\n"))
+	maxWidth := p.MaxWidth
+	if maxWidth <= 0 {
+		maxWidth = GetTerminalWidth()
+	}
+	switch {
+	case p.BorderStyle != nil:
+		maxWidth -= 2 + 2*internal.GetStringMaxWidth(p.BorderStyle.Vertical)
+	case p.Boxed:
+		maxWidth -= DefaultBox.LeftPadding + DefaultBox.RightPadding + 2*internal.GetStringMaxWidth(DefaultBox.VerticalString)
 	}
 
-	return s, nil
+	tableWidth := p.separatorWidth() * (len(t.maxColumnWidths) - 1)
+	for _, width := range t.maxColumnWidths {
+		tableWidth += width
+	}
+
+	for tableWidth > maxWidth {
+		widest := -1
+		for i, width := range t.maxColumnWidths {
+			if i >= len(p.Columns) && p.MaxWidth <= 0 {
+				continue
+			}
+			if column := p.column(i); column.Overflow != TableOverflowWrap || width <= 1 || width <= column.MinWidth {
+				continue
+			}
+			if widest == -1 || width > t.maxColumnWidths[widest] {
+				widest = i
+			}
+		}
+		if widest == -1 {
+			return
+		}
+		t.maxColumnWidths[widest]--
+		tableWidth--
+	}
 }
 
-// renderRow renders a row.
+// renderRow renders the row with the index r.
 // It merges the cells of a row into one string.
 // Each line of each cell is merged with the same line of the other cells.
-func (p TablePrinter) renderRow(t table, r row) string {
+// Cells spanning multiple columns or rows are merged into a single, wider or higher cell.
+func (p TablePrinter) renderRow(t table, r int) string {
 	var s string
 
+	// the row ends after the last column that is covered by a cell, which has content in this row
+	lastColumn := len(t.grid[r]) - 1
+	for lastColumn >= 0 && (t.grid[r][lastColumn] == -1 || t.spannedCellIsEmpty(t.cellAt(r, lastColumn), r)) {
+		lastColumn--
+	}
+
 	// merge lines of cells and add separator
 	// use the t.maxColumnWidths to add padding to the corresponding cell
 	// a newline in a cell should be in the same column as the original cell
-	for i := 0; i < r.height; i++ {
-		for j, c := range r.cells {
-			var currentLine string
-			if i < len(c.lines) {
-				currentLine = c.lines[i]
-			}
-			paddingForLine := t.maxColumnWidths[j] - internal.GetStringMaxWidth(currentLine)
+	for i := 0; i < t.rows[r].height; i++ {
+		for column := 0; column <= lastColumn; {
+			c := t.cellAt(r, column)
+			isLastCell := column+c.colSpan-1 >= lastColumn
 
-			if p.RightAlignment {
-				s += strings.Repeat(" ", paddingForLine)
-			}
+			s += p.renderCellLine(t, c, r, i, !isLastCell)
 
-			if i < len(c.lines) {
-				s += c.lines[i]
+			if !isLastCell {
+				s += p.SeparatorStyle.Sprint(p.Separator)
 			}
+			column += c.colSpan
+		}
+		s += "\n"
+	}
 
-			if j < len(r.cells)-1 {
-				if p.LeftAlignment {
-					s += strings.Repeat(" ", paddingForLine)
-				}
-				s += p.SeparatorStyle.Sprint(p.Separator)
+	return s
+}
+
+// spannedCellIsEmpty returns true, if the cell spans into the row r from a previous row and has no lines left to show in it.
+func (t table) spannedCellIsEmpty(c cell, r int) bool {
+	if c.row >= r {
+		return false
+	}
+
+	var lineIndex int
+	for prev := c.row; prev < r; prev++ {
+		lineIndex += t.rows[prev].height
+	}
+	return lineIndex >= len(c.lines)
+}
+
+// renderCellLine renders the i-th line of the cell c in the row r, aligned to the width of the cell.
+// If padRight is false, no trailing padding is added after left-aligned or centered content.
+func (p TablePrinter) renderCellLine(t table, c cell, r, i int, padRight bool) string {
+	// cells spanning multiple rows continue with the lines of the previous rows
+	lineIndex := i
+	for prev := c.row; prev < r; prev++ {
+		lineIndex += t.rows[prev].height
+	}
+
+	var currentLine string
+	if lineIndex < len(c.lines) {
+		currentLine = c.lines[lineIndex]
+	}
+	paddingForLine := p.spanWidth(t, c) - internal.GetStringMaxWidth(currentLine)
+	if paddingForLine < 0 {
+		paddingForLine = 0
+	}
+	if c.style != nil && currentLine != "" {
+		currentLine = c.style.Sprint(currentLine)
+	}
+
+	alignment := p.columnAlignment(c.column)
+	if alignment == TableAlignmentDefault && p.BorderStyle != nil {
+		alignment = TableAlignmentLeft
+	}
+
+	switch alignment {
+	case TableAlignmentRight:
+		return strings.Repeat(" ", paddingForLine) + currentLine
+	case TableAlignmentCenter:
+		currentLine = strings.Repeat(" ", paddingForLine/2) + currentLine
+		if padRight {
+			currentLine += strings.Repeat(" ", paddingForLine-paddingForLine/2)
+		}
+	case TableAlignmentLeft:
+		if padRight {
+			currentLine += strings.Repeat(" ", paddingForLine)
+		}
+	}
+
+	return currentLine
+}
+
+// renderGrid renders the table as a grid, using the BorderStyle of the TablePrinter.
+func (p TablePrinter) renderGrid(t table) string {
+	// a table without rows has no borders, as there is nothing to enclose
+	if len(t.rows) == 0 {
+		return ""
+	}
+
+	s := p.renderGridBody(t)
+
+	if !p.BorderStyle.OmitTopAndBottom {
+		s += p.renderGridLine(t, len(t.rows), p.SeparatorStyle)
+	}
+
+	return s
+}
+
+// renderGridBody renders the table as a grid, without the bottom border.
+func (p TablePrinter) renderGridBody(t table) string {
+	var s string
+
+	headerRows := t.headerRows(p.HasHeader)
+	footerRow := t.footerRow(p.hasFooter())
+	if !p.BorderStyle.OmitTopAndBottom {
+		s += p.renderGridLine(t, 0, p.SeparatorStyle)
+	}
+
+	for r := range t.rows {
+		switch {
+		case r > 0 && (r == headerRows || r == footerRow):
+			s += p.renderGridLine(t, r, p.HeaderRowSeparatorStyle)
+		case r > 0 && (r < headerRows || p.RowSeparator != ""):
+			s += p.renderGridLine(t, r, p.RowSeparatorStyle)
+		}
+
+		var style *Style
+		switch {
+		case r < headerRows:
+			style = p.HeaderStyle
+		case r == footerRow:
+			style = p.FooterStyle
+		}
+		s += p.renderGridRow(t, r, style)
+	}
+
+	return s
+}
+
+// renderGridRow renders the content of the row with the index r, enclosed by vertical lines.
+// If style is not nil, the content of the cells is printed with it.
+func (p TablePrinter) renderGridRow(t table, r int, style *Style) string {
+	var s string
+
+	for i := 0; i < t.rows[r].height; i++ {
+		s += p.SeparatorStyle.Sprint(p.BorderStyle.Vertical)
+		for column := 0; column < len(t.maxColumnWidths); {
+			c := t.cellAt(r, column)
+			content := " " + p.renderCellLine(t, c, r, i, true) + " "
+			if style != nil {
+				content = style.Sprint(content)
 			}
+			s += content + p.SeparatorStyle.Sprint(p.BorderStyle.Vertical)
+			column += c.colSpan
 		}
 		s += "\n"
 	}
@@ -279,10 +1159,412 @@ func (p TablePrinter) renderRow(t table, r row) string {
 	return s
 }
 
+// renderGridLine renders the horizontal line above the row with the index r.
+// Cells spanning across the line interrupt it, and the junctions are chosen by the lines meeting at each point.
+func (p TablePrinter) renderGridLine(t table, r int, style *Style) string {
+	b := p.BorderStyle
+	columns := len(t.maxColumnWidths)
+	var s string
+
+	for column := 0; column <= columns; column++ {
+		up := r > 0 && (column == 0 || column == columns || t.cellID(r-1, column-1) != t.cellID(r-1, column))
+		down := r < len(t.rows) && (column == 0 || column == columns || t.cellID(r, column-1) != t.cellID(r, column))
+		left := column > 0 && (r == 0 || r == len(t.rows) || t.cellID(r-1, column-1) != t.cellID(r, column-1))
+		right := column < columns && (r == 0 || r == len(t.rows) || t.cellID(r-1, column) != t.cellID(r, column))
+
+		s += b.junction(up, down, left, right)
+
+		if column < columns {
+			width := t.maxColumnWidths[column] + 2
+			if right {
+				s += strings.Repeat(b.Horizontal, width/max(internal.GetStringMaxWidth(b.Horizontal), 1))
+			} else {
+				s += strings.Repeat(" ", width)
+			}
+		}
+	}
+
+	return style.Sprint(s) + "\n"
+}
+
 // Render prints the TablePrinter to the terminal.
+// If Pager is true and the table is taller than the terminal, the table is shown in an interactive pager.
+// The pager is only used if both the standard input and the Writer are terminals, otherwise the whole table is printed.
 func (p TablePrinter) Render() error {
 	s, _ := p.Srender()
+
+	if p.Pager && strings.Count(s, "\n") >= GetTerminalHeight() {
+		if output, ok := p.pagerOutput(); ok {
+			return p.renderPager(s, output)
+		}
+	}
+
 	Fprintln(p.Writer, s)
 
 	return nil
 }
+
+// pagerOutput returns the terminal, which the pager renders to.
+// It returns false, if the standard input or the Writer of the TablePrinter is not a terminal.
+func (p TablePrinter) pagerOutput() (*os.File, bool) {
+	writer := p.Writer
+	if writer == nil {
+		writer = defaultOutput
+	}
+
+	output, ok := writer.(*os.File)
+	if !ok || !term.IsTerminal(int(output.Fd())) || !term.IsTerminal(int(os.Stdin.Fd())) {
+		return nil, false
+	}
+	return output, true
+}
+
+// renderPager shows the rendered table s in an interactive pager, which renders to the output.
+// The header of the table stays at the top, while the remaining lines can be scrolled.
+func (p TablePrinter) renderPager(s string, output *os.File) error {
+	cancel, exit := internal.NewCancelationSignal(nil)
+	defer exit()
+
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	height := GetTerminalHeight() - 1
+
+	headerLines := p.headerLineCount(p.newTable())
+	if height-headerLines < 1 {
+		headerLines = 0
+	}
+	header, body := lines[:headerLines], lines[headerLines:]
+	pageSize := height - headerLines
+	var offset int
+
+	renderPage := func() string {
+		end := min(offset+pageSize, len(body))
+		page := append(append([]string{}, header...), body[offset:end]...)
+		status := Sprintf("lines %d-%d of %d", offset+1, end, len(body))
+		page = append(page, ThemeDefault.SecondaryStyle.Sprint(status+" [↑/↓, PgUp/PgDn, q to quit]"))
+		return strings.Join(page, "\n")
+	}
+
+	area, err := DefaultArea.WithWriter(output).Start(renderPage())
+	if err != nil {
+		return fmt.Errorf("could not start area: %w", err)
+	}
+	defer area.Stop()
+
+	c := cursor.NewCursor().WithWriter(output)
+	c.Hide()
+	defer c.Show()
+
+	err = keyboard.Listen(func(keyInfo keys.Key) (stop bool, err error) {
+		switch keyInfo.Code {
+		case keys.Null:
+			// empty key presses do not change the page, so the page is not rendered again
+			return false, nil
+		case keys.Up:
+			offset--
+		case keys.Down:
+			offset++
+		case keys.PgUp:
+			offset -= pageSize
+		case keys.PgDown, keys.Space:
+			offset += pageSize
+		case keys.Home:
+			offset = 0
+		case keys.End:
+			offset = len(body)
+		case keys.RuneKey:
+			switch keyInfo.String() {
+			case "k":
+				offset--
+			case "j":
+				offset++
+			case "q":
+				return true, nil
+			}
+		case keys.Esc, keys.Enter:
+			return true, nil
+		case keys.CtrlC:
+			cancel()
+			return true, nil
+		}
+
+		offset = max(min(offset, len(body)-pageSize), 0)
+		area.Update(renderPage())
+
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start keyboard listener: %w", err)
+	}
+
+	return nil
+}
+
+// headerLineCount returns the number of rendered lines, which belong to the header of the table.
+// This includes borders and separators above and below the header.
+func (p TablePrinter) headerLineCount(t table) int {
+	headerRows := t.headerRows(p.HasHeader)
+	if headerRows == 0 {
+		return 0
+	}
+
+	var count int
+	for r := 0; r < headerRows; r++ {
+		count += t.rows[r].height
+	}
+
+	switch {
+	case p.BorderStyle != nil:
+		count += headerRows - 1
+		if !p.BorderStyle.OmitTopAndBottom {
+			count++
+		}
+		if headerRows < len(t.rows) {
+			count++
+		}
+	default:
+		if p.HeaderRowSeparator != "" {
+			count++
+		}
+		if p.Boxed {
+			count++
+		}
+	}
+
+	return count
+}
+
+// SrenderMarkdown renders the TablePrinter as a GitHub flavored markdown table.
+// If HasHeader is false, an empty header row is added, as markdown tables always need a header.
+// Colors are removed, and cells spanning multiple columns or rows are followed by empty cells.
+func (p TablePrinter) SrenderMarkdown() (string, error) {
+	t := p.placeCells()
+	rows := t.exportRows()
+
+	if !p.HasHeader || len(rows) == 0 {
+		rows = append([][]string{make([]string, len(t.maxColumnWidths))}, rows...)
+	}
+
+	widths := make([]int, len(t.maxColumnWidths))
+	for i, r := range rows {
+		for j, text := range r {
+			text = strings.ReplaceAll(strings.ReplaceAll(text, "|", "\\|"), "\n", "<br>")
+			rows[i][j] = text
+			widths[j] = max(widths[j], internal.GetStringMaxWidth(text), 3)
+		}
+	}
+
+	renderMarkdownRow := func(r []string) string {
+		s := "|"
+		for j, text := range r {
+			s += " " + text + strings.Repeat(" ", widths[j]-internal.GetStringMaxWidth(text)) + " |"
+		}
+		return s + "\n"
+	}
+
+	s := renderMarkdownRow(rows[0])
+	s += "|"
+	for j, width := range widths {
+		switch p.columnAlignment(j) {
+		case TableAlignmentLeft:
+			s += " :" + strings.Repeat("-", width-1) + " |"
+		case TableAlignmentCenter:
+			s += " :" + strings.Repeat("-", width-2) + ": |"
+		case TableAlignmentRight:
+			s += " " + strings.Repeat("-", width-1) + ": |"
+		default:
+			s += " " + strings.Repeat("-", width) + " |"
+		}
+	}
+	s += "\n"
+
+	for _, r := range rows[1:] {
+		s += renderMarkdownRow(r)
+	}
+
+	return s, nil
+}
+
+// SrenderHTML renders the TablePrinter as an HTML table.
+// If HasHeader is true, the header rows are rendered inside a thead element.
+// Colors are removed, and cells spanning multiple columns or rows get colspan and rowspan attributes.
+func (p TablePrinter) SrenderHTML() (string, error) {
+	t := p.placeCells()
+	headerRows := t.headerRows(p.HasHeader)
+
+	var s strings.Builder
+	s.WriteString("<table>\n")
+
+	for r := range t.rows {
+		switch r {
+		case 0:
+			if headerRows > 0 {
+				s.WriteString("  <thead>\n")
+			} else {
+				s.WriteString("  <tbody>\n")
+			}
+		case headerRows:
+			s.WriteString("  </thead>\n  <tbody>\n")
+		}
+
+		tag := "td"
+		if r < headerRows {
+			tag = "th"
+		}
+
+		s.WriteString("    <tr>\n")
+		for _, c := range t.cells {
+			if c.row != r {
+				continue
+			}
+
+			s.WriteString("      <" + tag)
+			if c.colSpan > 1 {
+				s.WriteString(Sprintf(` colspan="%d"`, c.colSpan))
+			}
+			if c.rowSpan > 1 {
+				s.WriteString(Sprintf(` rowspan="%d"`, c.rowSpan))
+			}
+			switch p.columnAlignment(c.column) {
+			case TableAlignmentCenter:
+				s.WriteString(` style="text-align: center"`)
+			case TableAlignmentRight:
+				s.WriteString(` style="text-align: right"`)
+			}
+			s.WriteString(">")
+
+			lines := make([]string, len(c.lines))
+			for i, l := range c.lines {
+				lines[i] = html.EscapeString(RemoveColorFromString(l))
+			}
+			s.WriteString(strings.Join(lines, "<br>"))
+			s.WriteString("</" + tag + ">\n")
+		}
+		s.WriteString("    </tr>\n")
+	}
+
+	switch {
+	case len(t.rows) == 0:
+	case headerRows == len(t.rows):
+		s.WriteString("  </thead>\n")
+	default:
+		s.WriteString("  </tbody>\n")
+	}
+	s.WriteString("</table>\n")
+
+	return s.String(), nil
+}
+
+// SrenderCSV renders the TablePrinter as CSV.
+// Colors are removed, and cells spanning multiple columns or rows are followed by empty fields.
+func (p TablePrinter) SrenderCSV() (string, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(p.placeCells().exportRows()); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// SrenderJSON renders the TablePrinter as JSON.
+// If HasHeader is true, every row is rendered as an object, which is keyed by the header of the columns.
+// Repeated keys, for example of a header cell spanning multiple columns, get the index of their column appended.
+// Otherwise, every row is rendered as an array of strings.
+// Colors are removed, and cells spanning multiple columns or rows are repeated as empty strings.
+func (p TablePrinter) SrenderJSON() (string, error) {
+	t := p.placeCells()
+	rows := t.exportRows()
+	headerRows := t.headerRows(p.HasHeader)
+
+	var v interface{} = rows
+	if headerRows > 0 {
+		// the bottom header row names the columns, as cells above it might group multiple columns
+		keys := make([]string, len(t.maxColumnWidths))
+		for column := range keys {
+			c := t.cellAt(headerRows-1, column)
+			keys[column] = RemoveColorFromString(strings.Join(c.lines, "\n"))
+		}
+		keys = uniqueTableJSONKeys(keys)
+
+		objects := make([]tableJSONObject, 0, len(rows)-headerRows)
+		for _, r := range rows[headerRows:] {
+			objects = append(objects, tableJSONObject{keys: keys, values: r})
+		}
+		v = objects
+	} else if rows == nil {
+		v = [][]string{}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// uniqueTableJSONKeys returns the keys, with the index of the column appended to repeated keys.
+// Header cells with the same text, or header cells spanning multiple columns, would otherwise produce duplicate keys.
+func uniqueTableJSONKeys(keys []string) []string {
+	seen := make(map[string]bool, len(keys))
+	result := make([]string, len(keys))
+	for column, key := range keys {
+		unique := key
+		for i := column; seen[unique]; i++ {
+			unique = key + "_" + strconv.Itoa(i)
+		}
+		seen[unique] = true
+		result[column] = unique
+	}
+	return result
+}
+
+// tableJSONObject is a row of a table, which is encoded as a JSON object with ordered keys.
+type tableJSONObject struct {
+	keys   []string
+	values []string
+}
+
+// MarshalJSON encodes the row as a JSON object, keeping the order of the columns.
+func (o tableJSONObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	buf.WriteString("{")
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		if err := enc.Encode(key); err != nil {
+			return nil, err
+		}
+		buf.WriteString(":")
+		if err := enc.Encode(o.values[i]); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteString("}")
+
+	return buf.Bytes(), nil
+}
+
+// exportRows returns the text of every position of the grid, without colors.
+// Positions which are not the top left corner of a cell are empty.
+func (t table) exportRows() [][]string {
+	var rows [][]string
+	for r := range t.rows {
+		cells := make([]string, len(t.maxColumnWidths))
+		for column := range cells {
+			if c := t.cellAt(r, column); c.row == r && c.column == column {
+				cells[column] = RemoveColorFromString(strings.Join(c.lines, "\n"))
+			}
+		}
+		rows = append(rows, cells)
+	}
+	return rows
+}
@@ -1,9 +1,12 @@
 package pterm_test
 
 import (
+	"bytes"
 	"encoding/csv"
 	"io"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/MarvinJWendt/testza"
@@ -183,3 +186,663 @@ func TestTablePrinter_WithWriter(t *testing.T) {
 	testza.AssertEqual(t, s, p2.Writer)
 	testza.AssertZero(t, p.Writer)
 }
+
+func TestTablePrinter_WithColumns(t *testing.T) {
+	c := []pterm.TableColumn{{Alignment: pterm.TableAlignmentCenter, MinWidth: 5, MaxWidth: 10, Overflow: pterm.TableOverflowTruncate}}
+	p := pterm.TablePrinter{}
+	p2 := p.WithColumns(c)
+
+	testza.AssertEqual(t, c, p2.Columns)
+	testza.AssertZero(t, p.Columns)
+}
+
+func TestTablePrinter_WithMaxWidth(t *testing.T) {
+	p := pterm.TablePrinter{}
+	p2 := p.WithMaxWidth(42)
+
+	testza.AssertEqual(t, 42, p2.MaxWidth)
+	testza.AssertZero(t, p.MaxWidth)
+}
+
+func TestTablePrinter_ColumnAlignment(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	d := pterm.TableData{
+		{"a", "b", "c"},
+		{"long", "long", "long"},
+	}
+	columns := []pterm.TableColumn{
+		{Alignment: pterm.TableAlignmentRight},
+		{Alignment: pterm.TableAlignmentCenter},
+		{Alignment: pterm.TableAlignmentLeft},
+	}
+	content, err := pterm.DefaultTable.WithColumns(columns).WithData(d).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "   a |  b   | c\nlong | long | long\n", content)
+}
+
+func TestTablePrinter_ColumnMinAndMaxWidth(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	d := pterm.TableData{
+		{"a", "hello world"},
+	}
+	columns := []pterm.TableColumn{
+		{MinWidth: 3},
+		{MaxWidth: 5},
+	}
+	content, err := pterm.DefaultTable.WithColumns(columns).WithData(d).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "a   | hello\n    | world\n", content)
+}
+
+func TestTablePrinter_ColumnTruncate(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	d := pterm.TableData{
+		{"hello world", "x"},
+	}
+	columns := []pterm.TableColumn{
+		{MaxWidth: 6, Overflow: pterm.TableOverflowTruncate},
+	}
+	content, err := pterm.DefaultTable.WithColumns(columns).WithData(d).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "hello… | x\n", content)
+}
+
+func TestTablePrinter_ShrinkToMaxWidth(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	d := pterm.TableData{
+		{"1", "This is a long description, which does not fit into the table"},
+		{"2", "Short"},
+	}
+	columns := []pterm.TableColumn{
+		{},
+		{Overflow: pterm.TableOverflowWrap},
+	}
+	content, err := pterm.DefaultTable.WithColumns(columns).WithMaxWidth(30).WithData(d).Srender()
+
+	testza.AssertNoError(t, err)
+	for _, line := range strings.Split(strings.TrimSuffix(content, "\n"), "\n") {
+		testza.AssertTrue(t, len(line) <= 30, line)
+	}
+	testza.AssertContains(t, content, "description, which does")
+}
+
+func TestTablePrinter_ShrinkToMaxWidthWithoutColumns(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	d := pterm.TableData{
+		{"1", "This is a long description, which does not fit into the table"},
+		{"2", "Short"},
+	}
+	content, err := pterm.DefaultTable.WithMaxWidth(20).WithData(d).Srender()
+
+	testza.AssertNoError(t, err)
+	for _, line := range strings.Split(strings.TrimSuffix(content, "\n"), "\n") {
+		testza.AssertTrue(t, len(line) <= 20, line)
+	}
+	testza.AssertContains(t, content, "description,")
+}
+
+func TestTablePrinter_DoesNotWrapWithoutColumnsOrMaxWidth(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	description := strings.Repeat("word ", 50)
+	content, err := pterm.DefaultTable.WithData(pterm.TableData{{"1", description}, {"2", "Short"}}).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "1 | "+description+"\n2 | Short\n", content)
+}
+
+func TestTablePrinter_ShrinkToTerminalWidth(t *testing.T) {
+	d := pterm.TableData{
+		{"1", strings.Repeat("word ", 50)},
+	}
+	columns := []pterm.TableColumn{
+		{},
+		{Overflow: pterm.TableOverflowWrap},
+	}
+	content, err := pterm.DefaultTable.WithColumns(columns).WithBoxed().WithData(d).Srender()
+
+	testza.AssertNoError(t, err)
+	for _, line := range strings.Split(content, "\n") {
+		testza.AssertTrue(t, len([]rune(pterm.RemoveColorFromString(line))) <= pterm.GetTerminalWidth(), line)
+	}
+}
+
+func TestTablePrinter_WithCells(t *testing.T) {
+	c := pterm.TableCells{{{Text: "a", ColSpan: 2}}, {{Text: "b"}, {Text: "c"}}}
+	p := pterm.TablePrinter{}
+	p2 := p.WithCells(c)
+
+	testza.AssertEqual(t, c, p2.Cells)
+	testza.AssertZero(t, p.Cells)
+}
+
+func TestTablePrinter_ColSpan(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	c := pterm.TableCells{
+		{{Text: "Name"}, {Text: "Contact", ColSpan: 2}},
+		{{Text: "Paul"}, {Text: "Dean"}, {Text: "paul@example.com"}},
+		{{Text: "Total", ColSpan: 2}, {Text: "1"}},
+	}
+	content, err := pterm.DefaultTable.WithCells(c).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Name | Contact\nPaul | Dean | paul@example.com\nTotal       | 1\n", content)
+}
+
+func TestTablePrinter_ColSpanWiderThanColumns(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	c := pterm.TableCells{
+		{{Text: "A very wide header", ColSpan: 2}},
+		{{Text: "a"}, {Text: "b"}},
+	}
+	content, err := pterm.DefaultTable.WithCells(c).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "A very wide header\na        | b\n", content)
+}
+
+func TestTablePrinter_RowSpan(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	c := pterm.TableCells{
+		{{Text: "Group", RowSpan: 2}, {Text: "a"}},
+		{{Text: "b"}},
+		{{Text: "Other"}, {Text: "c"}},
+	}
+	content, err := pterm.DefaultTable.WithCells(c).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Group | a\n      | b\nOther | c\n", content)
+}
+
+func TestTablePrinter_RowSpanInLastColumn(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	c := pterm.TableCells{
+		{{Text: "a"}, {Text: "b"}, {Text: "Group", RowSpan: 2}},
+		{{Text: "c"}, {Text: "d"}},
+	}
+	content, err := pterm.DefaultTable.WithCells(c).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "a | b | Group\nc | d\n", content)
+}
+
+func TestTablePrinter_RowSpanHigherThanRows(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	c := pterm.TableCells{
+		{{Text: "1\n2\n3", RowSpan: 2}, {Text: "a"}},
+		{{Text: "b"}},
+	}
+	content, err := pterm.DefaultTable.WithCells(c).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "1 | a\n2 | b\n3 | \n", content)
+}
+
+func TestTablePrinter_RowSpanInHeader(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	c := pterm.TableCells{
+		{{Text: "Name", RowSpan: 2}, {Text: "Q1", ColSpan: 2}},
+		{{Text: "Jan"}, {Text: "Feb"}},
+		{{Text: "Paul"}, {Text: "1"}, {Text: "2"}},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithCells(c).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Name | Q1\n     | Jan | Feb\n----------------\nPaul | 1   | 2\n", content)
+}
+
+func TestTablePrinter_CellStyle(t *testing.T) {
+	c := pterm.TableCells{
+		{{Text: "styled", Style: pterm.NewStyle(pterm.FgRed)}, {Text: "plain"}},
+	}
+	content, err := pterm.DefaultTable.WithCells(c).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertContains(t, content, pterm.NewStyle(pterm.FgRed).Sprint("styled"))
+}
+
+func TestTablePrinter_WithBorderStyle(t *testing.T) {
+	p := pterm.TablePrinter{}
+	p2 := p.WithBorderStyle(&pterm.TableBorderDouble)
+
+	testza.AssertEqual(t, &pterm.TableBorderDouble, p2.BorderStyle)
+	testza.AssertNil(t, p.BorderStyle)
+}
+
+func TestTablePrinter_BorderStyles(t *testing.T) {
+	d := pterm.TableData{
+		{"Firstname", "Lastname", "Email"},
+		{"Paul", "Dean", "nisi.dictum.augue@velitAliquam.co.uk"},
+		{"Callie", "Mckay", "egestas.nunc.sed@est.com"},
+	}
+	for _, style := range []pterm.TableBorderStyle{
+		pterm.TableBorderSingle,
+		pterm.TableBorderDouble,
+		pterm.TableBorderRounded,
+		pterm.TableBorderHeavy,
+		pterm.TableBorderASCII,
+		pterm.TableBorderMarkdown,
+	} {
+		style := style
+		content, err := pterm.DefaultTable.WithHasHeader().WithBorderStyle(&style).WithData(d).Srender()
+
+		testza.AssertNoError(t, err)
+		testza.AssertContains(t, content, "Callie")
+		testza.AssertContains(t, content, style.Cross)
+	}
+}
+
+func TestTablePrinter_BorderStyleGrid(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	d := pterm.TableData{
+		{"a", "b"},
+		{"1", "2"},
+		{"3", "4"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithRowSeparator("-").WithBorderStyle(&pterm.TableBorderSingle).WithData(d).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "┌───┬───┐\n│ a │ b │\n├───┼───┤\n│ 1 │ 2 │\n├───┼───┤\n│ 3 │ 4 │\n└───┴───┘\n", content)
+}
+
+func TestTablePrinter_BorderStyleMarkdown(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	d := pterm.TableData{
+		{"a", "b"},
+		{"1", "2"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithBorderStyle(&pterm.TableBorderMarkdown).WithData(d).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "| a | b |\n|---|---|\n| 1 | 2 |\n", content)
+}
+
+func TestTablePrinter_BorderStyleWithSpans(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	c := pterm.TableCells{
+		{{Text: "x", RowSpan: 2}, {Text: "ab", ColSpan: 2}},
+		{{Text: "a"}, {Text: "b"}},
+		{{Text: "total", ColSpan: 3}},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithBorderStyle(&pterm.TableBorderASCII).WithCells(c).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "+---+-------+\n| x | ab    |\n|   +---+---+\n|   | a | b |\n+---+---+---+\n| total     |\n+-----------+\n", content)
+}
+
+func TestTablePrinter_BorderStyleWithoutRows(t *testing.T) {
+	content, err := pterm.DefaultTable.WithBorderStyle(&pterm.TableBorderSingle).WithData(pterm.TableData{}).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertZero(t, content)
+}
+
+func TestTablePrinter_SrenderMarkdown(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Note"},
+		{pterm.Red("Paul"), "a | b"},
+		{"Callie", "multiple\nlines"},
+	}
+	columns := []pterm.TableColumn{{}, {Alignment: pterm.TableAlignmentRight}}
+	content, err := pterm.TablePrinter{}.WithHasHeader().WithColumns(columns).WithData(d).SrenderMarkdown()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "| Name   | Note              |\n| ------ | ----------------: |\n| Paul   | a \\| b            |\n| Callie | multiple<br>lines |\n", content)
+}
+
+func TestTablePrinter_SrenderMarkdownWithoutHeader(t *testing.T) {
+	d := pterm.TableData{
+		{"a", "b"},
+	}
+	content, err := pterm.TablePrinter{}.WithData(d).SrenderMarkdown()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "|     |     |\n| --- | --- |\n| a   | b   |\n", content)
+}
+
+func TestTablePrinter_SrenderHTML(t *testing.T) {
+	c := pterm.TableCells{
+		{{Text: "Name", RowSpan: 2}, {Text: "Contact", ColSpan: 2}},
+		{{Text: "Phone"}, {Text: "Email"}},
+		{{Text: "<Paul>"}, {Text: "123"}, {Text: "paul@example.com"}},
+	}
+	columns := []pterm.TableColumn{{}, {Alignment: pterm.TableAlignmentCenter}}
+	content, err := pterm.DefaultTable.WithHasHeader().WithColumns(columns).WithCells(c).SrenderHTML()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, `<table>
+  <thead>
+    <tr>
+      <th rowspan="2">Name</th>
+      <th colspan="2" style="text-align: center">Contact</th>
+    </tr>
+    <tr>
+      <th style="text-align: center">Phone</th>
+      <th>Email</th>
+    </tr>
+  </thead>
+  <tbody>
+    <tr>
+      <td>&lt;Paul&gt;</td>
+      <td style="text-align: center">123</td>
+      <td>paul@example.com</td>
+    </tr>
+  </tbody>
+</table>
+`, content)
+}
+
+func TestTablePrinter_SrenderCSV(t *testing.T) {
+	c := pterm.TableCells{
+		{{Text: "Name"}, {Text: "Note"}},
+		{{Text: "Paul"}, {Text: "a, \"b\""}},
+		{{Text: "Total", ColSpan: 2}},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithCells(c).SrenderCSV()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Name,Note\nPaul,\"a, \"\"b\"\"\"\nTotal,\n", content)
+}
+
+func TestTablePrinter_SrenderJSON(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Email"},
+		{"Paul", "<paul@example.com>"},
+		{"Callie"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithData(d).SrenderJSON()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, `[
+  {
+    "Name": "Paul",
+    "Email": "<paul@example.com>"
+  },
+  {
+    "Name": "Callie",
+    "Email": ""
+  }
+]
+`, content)
+}
+
+func TestTablePrinter_SrenderJSONDuplicateKeys(t *testing.T) {
+	d := pterm.TableData{
+		{"a", "a", "a_1"},
+		{"1", "2", "3"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithData(d).SrenderJSON()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "[\n  {\n    \"a\": \"1\",\n    \"a_1\": \"2\",\n    \"a_1_2\": \"3\"\n  }\n]\n", content)
+}
+
+func TestTablePrinter_SrenderJSONSpannedHeader(t *testing.T) {
+	c := pterm.TableCells{
+		{{Text: "Name", ColSpan: 2}},
+		{{Text: "Paul"}, {Text: "Dean"}},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithCells(c).SrenderJSON()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "[\n  {\n    \"Name\": \"Paul\",\n    \"Name_1\": \"Dean\"\n  }\n]\n", content)
+}
+
+func TestTablePrinter_SrenderJSONWithoutHeader(t *testing.T) {
+	d := pterm.TableData{
+		{"Paul", "Dean"},
+	}
+	content, err := pterm.DefaultTable.WithData(d).SrenderJSON()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "[\n  [\n    \"Paul\",\n    \"Dean\"\n  ]\n]\n", content)
+
+	content, err = pterm.DefaultTable.SrenderJSON()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "[]\n", content)
+}
+
+func TestTablePrinter_WithPager(t *testing.T) {
+	p := pterm.TablePrinter{}
+	p2 := p.WithPager()
+
+	testza.AssertTrue(t, p2.Pager)
+	testza.AssertFalse(t, p.Pager)
+}
+
+func TestTablePrinter_RenderPagerWithoutTerminal(t *testing.T) {
+	d := pterm.TableData{{"ID", "Name"}}
+	for i := 0; i < 200; i++ {
+		d = append(d, []string{strconv.Itoa(i), "Paul"})
+	}
+	printer := pterm.DefaultTable.WithHasHeader().WithBorderStyle(&pterm.TableBorderSingle).WithPager().WithData(d)
+	expected, err := printer.Srender()
+	testza.AssertNoError(t, err)
+
+	var buf bytes.Buffer
+	testza.AssertNoError(t, printer.WithWriter(&buf).Render())
+	testza.AssertEqual(t, expected+"\n", buf.String())
+
+	out := captureStdout(func(w io.Writer) {
+		testza.AssertNoError(t, printer.Render())
+	})
+	testza.AssertContains(t, out, "199")
+	testza.AssertNotContains(t, out, "lines 1-")
+}
+
+func TestTablePrinter_RenderPagerWithShortTable(t *testing.T) {
+	d := pterm.TableData{{"ID", "Name"}, {"1", "Paul"}}
+
+	out := captureStdout(func(w io.Writer) {
+		err := pterm.DefaultTable.WithHasHeader().WithPager().WithData(d).Render()
+		testza.AssertNoError(t, err)
+	})
+
+	testza.AssertContains(t, out, "Paul")
+}
+
+func TestTablePrinter_WithSort(t *testing.T) {
+	p := pterm.DefaultTable.WithSort(pterm.TableSort{Column: 1, Mode: pterm.TableSortNumeric, Descending: true})
+	testza.AssertEqual(t, &pterm.TableSort{Column: 1, Mode: pterm.TableSortNumeric, Descending: true}, p.Sort)
+}
+
+func TestTablePrinter_WithFilter(t *testing.T) {
+	p := pterm.DefaultTable.WithFilter(func(row []string) bool { return true })
+	testza.AssertNotNil(t, p.Filter)
+}
+
+func TestTablePrinter_WithFooterLabel(t *testing.T) {
+	p := pterm.DefaultTable.WithFooterLabel("Total")
+	testza.AssertEqual(t, "Total", p.FooterLabel)
+}
+
+func TestTablePrinter_WithFooterStyle(t *testing.T) {
+	s := pterm.NewStyle(pterm.FgRed)
+	p := pterm.DefaultTable.WithFooterStyle(s)
+	testza.AssertEqual(t, s, p.FooterStyle)
+}
+
+func TestTablePrinter_Sort(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Size"},
+		{"file10", "10"},
+		{"file2", "n/a"},
+		{"file1", "2"},
+		{"File3", "1.5"},
+	}
+	tests := []struct {
+		sort     pterm.TableSort
+		expected string
+	}{
+		{pterm.TableSort{Column: 0}, "Name,Size\nFile3,1.5\nfile1,2\nfile10,10\nfile2,n/a\n"},
+		{pterm.TableSort{Column: 0, Mode: pterm.TableSortNatural}, "Name,Size\nFile3,1.5\nfile1,2\nfile2,n/a\nfile10,10\n"},
+		{pterm.TableSort{Column: 1, Mode: pterm.TableSortNumeric}, "Name,Size\nFile3,1.5\nfile1,2\nfile10,10\nfile2,n/a\n"},
+		{pterm.TableSort{Column: 1, Mode: pterm.TableSortNumeric, Descending: true}, "Name,Size\nfile10,10\nfile1,2\nFile3,1.5\nfile2,n/a\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(strconv.Itoa(int(test.sort.Mode)), func(t *testing.T) {
+			content, err := pterm.DefaultTable.WithHasHeader().WithData(d).WithSort(test.sort).SrenderCSV()
+
+			testza.AssertNoError(t, err)
+			testza.AssertEqual(t, test.expected, content)
+		})
+	}
+}
+
+func TestTablePrinter_SortIsStable(t *testing.T) {
+	d := pterm.TableData{
+		{"a", "1"},
+		{"b", "0"},
+		{"c", "1"},
+		{"d", "0"},
+	}
+	content, err := pterm.DefaultTable.WithData(d).WithSort(pterm.TableSort{Column: 1}).SrenderCSV()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "b,0\nd,0\na,1\nc,1\n", content)
+}
+
+func TestTablePrinter_Filter(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Role"},
+		{"Paul", "admin"},
+		{"Callie", "user"},
+		{"Libby", "admin"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithData(d).WithFilter(func(row []string) bool {
+		return row[1] == "admin"
+	}).SrenderCSV()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Name,Role\nPaul,admin\nLibby,admin\n", content)
+}
+
+func TestTablePrinter_SortAndFilterWithRowSpan(t *testing.T) {
+	c := pterm.TableCells{
+		{{Text: "Team"}, {Text: "Name"}},
+		{{Text: "b", RowSpan: 2}, {Text: "Paul"}},
+		{{Text: "Callie"}},
+		{{Text: "a"}, {Text: "Libby"}},
+	}
+	printer := pterm.DefaultTable.WithHasHeader().WithCells(c)
+
+	content, err := printer.WithSort(pterm.TableSort{Column: 0}).SrenderCSV()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Team,Name\na,Libby\nb,Paul\n,Callie\n", content)
+
+	content, err = printer.WithFilter(func(row []string) bool { return row[0] == "b" }).SrenderCSV()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Team,Name\nb,Paul\n,Callie\n", content)
+
+	content, err = printer.WithFilter(func(row []string) bool { return row[0] == "a" }).SrenderCSV()
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Team,Name\na,Libby\n", content)
+}
+
+func TestTablePrinter_Footer(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Count", "Sum", "Avg", "Min", "Max"},
+		{"Paul", "x", "1.5", "1", "3", "3"},
+		{"Callie", "", "2", "2", "-1", "7"},
+		{"Libby", "y", "n/a", "4", "2", "5"},
+	}
+	columns := []pterm.TableColumn{
+		{},
+		{Aggregation: pterm.TableAggregationCount},
+		{Aggregation: pterm.TableAggregationSum},
+		{Aggregation: pterm.TableAggregationAvg},
+		{Aggregation: pterm.TableAggregationMin},
+		{Aggregation: pterm.TableAggregationMax},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithData(d).WithColumns(columns).WithFooterLabel("Total").SrenderCSV()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Name,Count,Sum,Avg,Min,Max\nPaul,x,1.5,1,3,3\nCallie,,2,2,-1,7\nLibby,y,n/a,4,2,5\nTotal,2,3.5,2.33,-1,7\n", content)
+}
+
+func TestTablePrinter_FooterAfterFilter(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Amount"},
+		{"Paul", "10"},
+		{"Callie", "20"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithData(d).
+		WithColumns([]pterm.TableColumn{{}, {Aggregation: pterm.TableAggregationSum}}).
+		WithFilter(func(row []string) bool { return row[0] != "Callie" }).
+		SrenderCSV()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, "Name,Amount\nPaul,10\n,10\n", content)
+}
+
+func TestTablePrinter_FooterRender(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Amount"},
+		{"Paul", "10"},
+		{"Callie", "20"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithData(d).WithHeaderRowSeparator("-").WithFooterLabel("Total").
+		WithColumns([]pterm.TableColumn{{}, {Aggregation: pterm.TableAggregationSum}}).Srender()
+
+	testza.AssertNoError(t, err)
+	lines := strings.Split(pterm.RemoveColorFromString(content), "\n")
+	testza.AssertLen(t, lines, 7)
+	testza.AssertEqual(t, strings.Repeat("-", 15), lines[4])
+	testza.AssertContains(t, lines[5], "Total")
+	testza.AssertContains(t, lines[5], "30")
+}
+
+func TestTablePrinter_FooterRenderGrid(t *testing.T) {
+	d := pterm.TableData{
+		{"Name", "Amount"},
+		{"Paul", "10"},
+		{"Callie", "20"},
+	}
+	content, err := pterm.DefaultTable.WithHasHeader().WithData(d).WithBorderStyle(&pterm.TableBorderASCII).
+		WithColumns([]pterm.TableColumn{{}, {Aggregation: pterm.TableAggregationSum}}).Srender()
+
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, `+--------+--------+
+| Name   | Amount |
++--------+--------+
+| Paul   | 10     |
+| Callie | 20     |
++--------+--------+
+|        | 30     |
++--------+--------+
+`, pterm.RemoveColorFromString(content))
+}
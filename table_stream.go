@@ -0,0 +1,152 @@
+package pterm
+
+import (
+	"strings"
+	"sync"
+)
+
+// TableStream renders the rows of a table as soon as they are appended.
+// Use TablePrinter.StartStream to create a TableStream.
+//
+// As every row is printed immediately, the widths of the columns are fixed when the stream starts.
+// They are calculated from the initial Data of the TablePrinter and the MinWidth and MaxWidth of its Columns.
+// Appended cells, which are wider than their column, are wrapped or truncated according to the Overflow of the column.
+type TableStream struct {
+	printer      TablePrinter
+	columnWidths []int
+	rowCount     int
+	isActive     bool
+	mu           sync.Mutex
+}
+
+// StartStream renders the initial Data of the TablePrinter, including its header, and returns a TableStream.
+// Rows appended to the TableStream are rendered immediately below.
+// Cell spans, Boxed and footers are not supported while streaming.
+// Sort only applies to the initial Data, while Filter also applies to appended rows.
+//
+// Example:
+//
+//	stream, _ := pterm.DefaultTable.WithHasHeader().WithData(pterm.TableData{{"ID", "Name"}}).StartStream()
+//	stream.AppendRow("1", "Paul")
+//	stream.Stop()
+func (p TablePrinter) StartStream() (*TableStream, error) {
+	p = p.withDefaultStyles()
+	p.Cells = nil
+
+	columns := make([]TableColumn, len(p.Columns))
+	for i, column := range p.Columns {
+		column.Aggregation = TableAggregationNone
+		columns[i] = column
+	}
+	p.Columns = columns
+
+	t := p.newTable()
+	for i := len(t.maxColumnWidths); i < len(p.Columns); i++ {
+		t.maxColumnWidths = append(t.maxColumnWidths, p.Columns[i].MinWidth)
+	}
+
+	// the initial rows are already sorted and filtered, appended rows are filtered in AppendRow
+	p.Sort = nil
+	s := &TableStream{
+		printer:      p,
+		columnWidths: t.maxColumnWidths,
+		rowCount:     len(t.rows),
+		isActive:     true,
+	}
+
+	if p.BorderStyle != nil {
+		Fprint(p.Writer, p.renderGridBody(t))
+	} else {
+		Fprint(p.Writer, p.renderPlain(t))
+	}
+
+	return s, nil
+}
+
+// AppendRow renders a row below the previously rendered rows.
+// It is safe to call AppendRow from multiple goroutines.
+func (s *TableStream) AppendRow(cells ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isActive || (s.printer.Filter != nil && !s.printer.Filter(cells)) {
+		return
+	}
+
+	Fprint(s.printer.Writer, s.renderRow(cells))
+	s.rowCount++
+}
+
+// Stop ends the TableStream and renders the bottom border of the table, if the table has one.
+func (s *TableStream) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isActive {
+		return nil
+	}
+	s.isActive = false
+
+	if p := s.printer; p.BorderStyle != nil && !p.BorderStyle.OmitTopAndBottom {
+		skeleton := s.skeleton(s.columnWidths)
+		Fprint(p.Writer, p.renderGridLine(skeleton, len(skeleton.rows), p.SeparatorStyle))
+	}
+
+	return nil
+}
+
+// renderRow renders a single row with the fixed column widths of the TableStream.
+func (s *TableStream) renderRow(cells []string) string {
+	p := s.printer
+	p.Data = TableData{cells}
+	p.Filter = nil
+
+	t := p.placeCells()
+	for i := range t.maxColumnWidths {
+		if i < len(s.columnWidths) {
+			t.maxColumnWidths[i] = s.columnWidths[i]
+		} else {
+			t.maxColumnWidths[i] = t.cellAt(0, i).width
+		}
+	}
+	p.fitCells(&t)
+
+	var ret string
+	if p.BorderStyle != nil {
+		headerRows := 0
+		if p.HasHeader {
+			headerRows = 1
+		}
+		if s.rowCount > 0 && (s.rowCount == headerRows || p.RowSeparator != "") {
+			style := p.RowSeparatorStyle
+			if s.rowCount == headerRows {
+				style = p.HeaderRowSeparatorStyle
+			}
+			ret += p.renderGridLine(s.skeleton(t.maxColumnWidths), 1, style)
+		}
+		return ret + p.renderGridRow(t, 0, nil)
+	}
+
+	ret = p.renderRow(t, 0)
+	if p.RowSeparator != "" {
+		width := p.separatorWidth() * (len(t.maxColumnWidths) - 1)
+		for _, w := range t.maxColumnWidths {
+			width += w
+		}
+		ret += strings.Repeat(p.RowSeparatorStyle.Sprint(p.RowSeparator), width) + "\n"
+	}
+
+	return ret
+}
+
+// skeleton returns an empty table with two rows and the given column widths.
+// It is used to render the horizontal lines of the grid.
+func (s *TableStream) skeleton(columnWidths []int) table {
+	p := s.printer
+	p.Data = TableData{make([]string, len(columnWidths)), make([]string, len(columnWidths))}
+
+	t := p.placeCells()
+	copy(t.maxColumnWidths, columnWidths)
+
+	return t
+}
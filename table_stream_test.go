@@ -0,0 +1,101 @@
+package pterm_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func TestTableStream_AppendRow(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	var buf bytes.Buffer
+	stream, err := pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithWriter(&buf).WithData(pterm.TableData{{"ID", "Name"}}).StartStream()
+	testza.AssertNoError(t, err)
+
+	stream.AppendRow("1", "Paul")
+	stream.AppendRow("22", "Dean")
+	testza.AssertNoError(t, stream.Stop())
+
+	testza.AssertEqual(t, "ID | Name\n---------\n1  | Paul\n22 | Dean\n", buf.String())
+}
+
+func TestTableStream_FixedColumnWidths(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	var buf bytes.Buffer
+	columns := []pterm.TableColumn{{MinWidth: 3}, {MinWidth: 5, Overflow: pterm.TableOverflowTruncate}}
+	stream, err := pterm.DefaultTable.WithColumns(columns).WithWriter(&buf).StartStream()
+	testza.AssertNoError(t, err)
+
+	stream.AppendRow("1", "Paul")
+	stream.AppendRow("2", "Callie")
+	testza.AssertNoError(t, stream.Stop())
+
+	testza.AssertEqual(t, "1   | Paul\n2   | Call…\n", buf.String())
+}
+
+func TestTableStream_BorderStyle(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	var buf bytes.Buffer
+	stream, err := pterm.DefaultTable.WithHasHeader().WithBorderStyle(&pterm.TableBorderASCII).WithWriter(&buf).WithData(pterm.TableData{{"ID", "Name"}}).StartStream()
+	testza.AssertNoError(t, err)
+
+	stream.AppendRow("1", "Paul")
+	stream.AppendRow("2", "Dean")
+	testza.AssertNoError(t, stream.Stop())
+
+	testza.AssertEqual(t, "+----+------+\n| ID | Name |\n+----+------+\n| 1  | Paul |\n| 2  | Dean |\n+----+------+\n", buf.String())
+}
+
+func TestTableStream_BorderStyleWithRowSeparator(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	var buf bytes.Buffer
+	stream, err := pterm.DefaultTable.WithRowSeparator("-").WithBorderStyle(&pterm.TableBorderASCII).WithWriter(&buf).WithData(pterm.TableData{{"a", "b"}}).StartStream()
+	testza.AssertNoError(t, err)
+
+	stream.AppendRow("c", "d")
+	testza.AssertNoError(t, stream.Stop())
+
+	testza.AssertEqual(t, "+---+---+\n| a | b |\n+---+---+\n| c | d |\n+---+---+\n", buf.String())
+}
+
+func TestTableStream_Concurrent(t *testing.T) {
+	var buf bytes.Buffer
+	stream, err := pterm.DefaultTable.WithWriter(&buf).WithData(pterm.TableData{{"ID", "Name"}}).StartStream()
+	testza.AssertNoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stream.AppendRow("1", "Paul")
+		}()
+	}
+	wg.Wait()
+	testza.AssertNoError(t, stream.Stop())
+
+	testza.AssertEqual(t, 11, bytes.Count(buf.Bytes(), []byte("\n")))
+}
+
+func TestTableStream_AppendAfterStop(t *testing.T) {
+	var buf bytes.Buffer
+	stream, _ := pterm.DefaultTable.WithWriter(&buf).StartStream()
+	testza.AssertNoError(t, stream.Stop())
+	testza.AssertNoError(t, stream.Stop())
+
+	stream.AppendRow("1", "Paul")
+
+	testza.AssertZero(t, buf.Len())
+}
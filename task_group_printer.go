@@ -0,0 +1,441 @@
+package pterm
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"atomicgo.dev/schedule"
+
+	"github.com/pterm/pterm/internal"
+)
+
+// DefaultTaskGroup is the default TaskGroupPrinter.
+var DefaultTaskGroup = TaskGroupPrinter{
+	Progressbar:          DefaultProgressbar,
+	TreeStyle:            &ThemeDefault.TreeStyle,
+	TopRightCornerString: "└",
+	TopRightDownString:   "├",
+	HorizontalString:     "─",
+	VerticalString:       "│",
+	UpdateDelay:          time.Millisecond * 100,
+}
+
+// TaskGroupPrinter groups multiple tasks below a parent progressbar.
+// The progress of the parent progressbar is the sum of the progress of all its children, which can be
+// ProgressbarPrinters, SpinnerPrinters or other task groups. The group is rendered as an indented tree.
+//
+// A child ProgressbarPrinter adds its total and current value to the parent.
+// A child SpinnerPrinter counts as one unit, which is done when the spinner is stopped.
+// If any child ProgressbarPrinter is indeterminate, the parent progressbar is indeterminate as well.
+type TaskGroupPrinter struct {
+	Title                string
+	Progressbar          ProgressbarPrinter
+	TreeStyle            *Style
+	TopRightCornerString string
+	TopRightDownString   string
+	HorizontalString     string
+	VerticalString       string
+	UpdateDelay          time.Duration
+	RemoveWhenDone       bool
+
+	IsActive bool
+
+	Writer io.Writer
+
+	root       *TaskGroupPrinter
+	mu         *sync.Mutex
+	bar        ProgressbarPrinter
+	depth      int
+	children   []*taskGroupChild
+	area       AreaPrinter
+	renderTask *schedule.Task
+}
+
+// taskGroupChild is a task in a TaskGroupPrinter.
+// Exactly one of group, progressbar and spinner is set.
+type taskGroupChild struct {
+	group       *TaskGroupPrinter
+	progressbar *ProgressbarPrinter
+	spinner     *SpinnerPrinter
+	writer      *liveWriter
+}
+
+// WithTitle returns a new TaskGroupPrinter with a specific Title.
+func (p TaskGroupPrinter) WithTitle(title string) *TaskGroupPrinter {
+	p.Title = title
+	return &p
+}
+
+// WithProgressbar returns a new TaskGroupPrinter, which renders its parent progressbars like the given ProgressbarPrinter.
+func (p TaskGroupPrinter) WithProgressbar(progressbar ProgressbarPrinter) *TaskGroupPrinter {
+	p.Progressbar = progressbar
+	return &p
+}
+
+// WithTreeStyle returns a new TaskGroupPrinter with a specific TreeStyle.
+func (p TaskGroupPrinter) WithTreeStyle(style *Style) *TaskGroupPrinter {
+	p.TreeStyle = style
+	return &p
+}
+
+// WithUpdateDelay returns a new TaskGroupPrinter with a specific UpdateDelay.
+func (p TaskGroupPrinter) WithUpdateDelay(delay time.Duration) *TaskGroupPrinter {
+	p.UpdateDelay = delay
+	return &p
+}
+
+// WithRemoveWhenDone returns a new TaskGroupPrinter, which removes children from the tree, when they are done.
+// Removed children still count towards the progress of their parent.
+func (p TaskGroupPrinter) WithRemoveWhenDone(b ...bool) *TaskGroupPrinter {
+	p.RemoveWhenDone = internal.WithBoolean(b)
+	return &p
+}
+
+// Start the TaskGroupPrinter.
+// Children can be added to the started TaskGroupPrinter with AddProgressbar, AddSpinner and AddGroup.
+func (p TaskGroupPrinter) Start(title ...interface{}) (*TaskGroupPrinter, error) {
+	if len(title) != 0 {
+		p.Title = Sprint(title...)
+	}
+	if p.TreeStyle == nil {
+		p.TreeStyle = NewStyle()
+	}
+	if p.UpdateDelay <= 0 {
+		p.UpdateDelay = DefaultTaskGroup.UpdateDelay
+	}
+
+	p.Progressbar.template = nil
+	if p.Progressbar.Template != "" {
+		tmpl, err := template.New("progressbar").Parse(p.Progressbar.Template)
+		if err != nil {
+			return &p, fmt.Errorf("could not parse progressbar template: %w", err)
+		}
+		p.Progressbar.template = tmpl
+	}
+
+	p.root = &p
+	p.mu = &sync.Mutex{}
+	p.children = nil
+	p.bar = p.newBar()
+	p.IsActive = true
+
+	p.area = *DefaultArea.WithWriter(p.Writer)
+	_, _ = p.area.Start(p.render())
+
+	p.renderTask = schedule.Every(p.UpdateDelay, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if !p.IsActive {
+			return false
+		}
+		p.area.Update(p.render())
+		return true
+	})
+
+	return &p, nil
+}
+
+// Stop stops all children, which are still running, and renders the TaskGroupPrinter a last time.
+// If the TaskGroupPrinter is a nested group, only its children are stopped.
+func (p *TaskGroupPrinter) Stop() (*TaskGroupPrinter, error) {
+	for _, child := range p.childrenSnapshot() {
+		switch {
+		case child.group != nil:
+			_, _ = child.group.Stop()
+		case child.progressbar != nil:
+			_, _ = child.progressbar.Stop()
+		case child.spinner != nil:
+			_ = child.spinner.Stop()
+		}
+	}
+
+	if p.root != p {
+		return p, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.IsActive {
+		return p, nil
+	}
+	p.IsActive = false
+	if p.renderTask != nil {
+		p.renderTask.Stop()
+	}
+	p.area.Update(p.render())
+	_ = p.area.Stop()
+
+	return p, nil
+}
+
+// AddProgressbar starts a ProgressbarPrinter as a child of the TaskGroupPrinter and returns it.
+// The progressbar is rendered by the TaskGroupPrinter, so its Writer is replaced.
+func (p *TaskGroupPrinter) AddProgressbar(progressbar ProgressbarPrinter, title ...interface{}) (*ProgressbarPrinter, error) {
+	if p.root == nil {
+		return &progressbar, ErrTaskGroupNotStarted
+	}
+
+	child := &taskGroupChild{writer: &liveWriter{}}
+	progressbar.Writer = child.writer
+	progressbar.MaxWidth = max(p.width()-p.prefixWidth(p.depth+1), 1)
+
+	pb, err := progressbar.Start(title...)
+	if err != nil {
+		return pb, err
+	}
+	child.progressbar = pb
+
+	return pb, p.addChild(child)
+}
+
+// AddSpinner starts a SpinnerPrinter as a child of the TaskGroupPrinter and returns it.
+// The spinner is rendered by the TaskGroupPrinter, so its Writer is replaced.
+func (p *TaskGroupPrinter) AddSpinner(spinner SpinnerPrinter, text ...interface{}) (*SpinnerPrinter, error) {
+	if p.root == nil {
+		return &spinner, ErrTaskGroupNotStarted
+	}
+
+	child := &taskGroupChild{writer: &liveWriter{}}
+	spinner.Writer = child.writer
+
+	s, err := spinner.Start(text...)
+	if err != nil {
+		return s, err
+	}
+	child.spinner = s
+
+	return s, p.addChild(child)
+}
+
+// AddGroup adds a nested task group as a child of the TaskGroupPrinter and returns it.
+// The nested group has its own parent progressbar, which sums up the progress of its children.
+func (p *TaskGroupPrinter) AddGroup(title string) (*TaskGroupPrinter, error) {
+	if p.root == nil {
+		return p, ErrTaskGroupNotStarted
+	}
+
+	group := *p
+	group.Title = title
+	group.depth = p.depth + 1
+	group.children = nil
+	group.bar = group.newBar()
+
+	return &group, p.addChild(&taskGroupChild{group: &group})
+}
+
+// Remove removes a child, which was added by AddProgressbar, AddSpinner or AddGroup, from the TaskGroupPrinter.
+// The removed child no longer counts towards the progress of its parent, and it is not stopped by Stop.
+// Unknown children are ignored.
+func (p *TaskGroupPrinter) Remove(child LivePrinter) {
+	if p.root == nil {
+		return
+	}
+
+	p.root.mu.Lock()
+	defer p.root.mu.Unlock()
+
+	for i, c := range p.children {
+		if (c.group != nil && LivePrinter(c.group) == child) ||
+			(c.progressbar != nil && LivePrinter(c.progressbar) == child) ||
+			(c.spinner != nil && LivePrinter(c.spinner) == child) {
+			p.children = append(p.children[:i:i], p.children[i+1:]...)
+			return
+		}
+	}
+}
+
+// Srender renders the current state of the TaskGroupPrinter as a string.
+func (p *TaskGroupPrinter) Srender() (string, error) {
+	if p.root == nil {
+		return "", ErrTaskGroupNotStarted
+	}
+
+	p.root.mu.Lock()
+	defer p.root.mu.Unlock()
+
+	var lines []string
+	p.renderGroup(&lines, "", "")
+	return strings.Join(lines, "\n"), nil
+}
+
+// addChild adds a child to the TaskGroupPrinter.
+func (p *TaskGroupPrinter) addChild(child *taskGroupChild) error {
+	if p.root == nil {
+		return ErrTaskGroupNotStarted
+	}
+
+	p.root.mu.Lock()
+	defer p.root.mu.Unlock()
+
+	p.children = append(p.children, child)
+	return nil
+}
+
+// childrenSnapshot returns a copy of the children, which can be used without holding the lock.
+func (p *TaskGroupPrinter) childrenSnapshot() []*taskGroupChild {
+	if p.root == nil {
+		return nil
+	}
+
+	p.root.mu.Lock()
+	defer p.root.mu.Unlock()
+
+	return append([]*taskGroupChild{}, p.children...)
+}
+
+// newBar returns the parent progressbar of the group.
+// It is never started, as it is rendered by the TaskGroupPrinter.
+func (p *TaskGroupPrinter) newBar() ProgressbarPrinter {
+	bar := p.Progressbar
+	bar.Title = p.Title
+	bar.IsActive = true
+	bar.startedAt = time.Now()
+	bar.MaxWidth = max(p.width()-p.prefixWidth(p.depth), 1)
+	return bar
+}
+
+// width returns the maximum width of the rendered tree.
+func (p *TaskGroupPrinter) width() int {
+	if p.Progressbar.MaxWidth <= 0 {
+		return GetTerminalWidth()
+	}
+	return min(p.Progressbar.MaxWidth, GetTerminalWidth())
+}
+
+// prefixWidth returns the width of the tree branches in front of a task at the given depth.
+func (p *TaskGroupPrinter) prefixWidth(depth int) int {
+	return depth * 3
+}
+
+// progress returns the summed up current and total value of all children.
+// It has to be called while the root TaskGroupPrinter is locked.
+func (p *TaskGroupPrinter) progress() (current, total int, indeterminate bool) {
+	for _, child := range p.children {
+		switch {
+		case child.group != nil:
+			c, t, i := child.group.progress()
+			current, total, indeterminate = current+c, total+t, indeterminate || i
+		case child.progressbar != nil:
+			unlock := child.progressbar.lock()
+			current += child.progressbar.Current
+			total += child.progressbar.Total
+			indeterminate = indeterminate || child.progressbar.isIndeterminate()
+			unlock()
+		case child.spinner != nil:
+			total++
+			if !child.spinner.isActive() {
+				current++
+			}
+		}
+	}
+
+	return current, total, indeterminate
+}
+
+// isDone returns true if a child is done.
+// A nested group is done, when all of its children are done.
+// It has to be called while the root TaskGroupPrinter is locked.
+func (c *taskGroupChild) isDone() bool {
+	switch {
+	case c.group != nil:
+		for _, child := range c.group.children {
+			if !child.isDone() {
+				return false
+			}
+		}
+		return len(c.group.children) > 0
+	case c.progressbar != nil:
+		unlock := c.progressbar.lock()
+		defer unlock()
+		return !c.progressbar.IsActive
+	case c.spinner != nil:
+		return !c.spinner.isActive()
+	}
+	return true
+}
+
+// render renders the TaskGroupPrinter and all of its children as a tree.
+// It has to be called while the root TaskGroupPrinter is locked.
+func (p *TaskGroupPrinter) render() string {
+	var lines []string
+	p.renderGroup(&lines, "", "")
+	return strings.Join(lines, "\n")
+}
+
+// renderGroup renders the parent progressbar of the group, followed by its children.
+// The prefix is printed in front of the parent progressbar, and the childPrefix in front of the branches of the children.
+func (p *TaskGroupPrinter) renderGroup(lines *[]string, prefix, childPrefix string) {
+	current, total, indeterminate := p.progress()
+	if indeterminate {
+		total = 0
+	}
+	p.bar.Current, p.bar.Total = current, total
+	*lines = append(*lines, p.TreeStyle.Sprint(prefix)+p.bar.getString())
+
+	var children []*taskGroupChild
+	for _, child := range p.children {
+		if !p.RemoveWhenDone || !child.isDone() {
+			children = append(children, child)
+		}
+	}
+
+	for i, child := range children {
+		branch := p.TopRightDownString + p.HorizontalString + " "
+		indent := p.VerticalString + "  "
+		if i == len(children)-1 {
+			branch = p.TopRightCornerString + p.HorizontalString + " "
+			indent = "   "
+		}
+
+		if child.group != nil {
+			child.group.renderGroup(lines, childPrefix+branch, childPrefix+indent)
+			continue
+		}
+		*lines = append(*lines, p.TreeStyle.Sprint(childPrefix+branch)+child.writer.String())
+	}
+}
+
+// GenericStart runs Start, but returns a LivePrinter.
+// This is used for the interface LivePrinter.
+// You most likely want to use Start instead of this in your program.
+func (p *TaskGroupPrinter) GenericStart() (*LivePrinter, error) {
+	p2, err := p.Start()
+	lp := LivePrinter(p2)
+	return &lp, err
+}
+
+// GenericStop runs Stop, but returns a LivePrinter.
+// This is used for the interface LivePrinter.
+// You most likely want to use Stop instead of this in your program.
+func (p *TaskGroupPrinter) GenericStop() (*LivePrinter, error) {
+	p2, err := p.Stop()
+	lp := LivePrinter(p2)
+	return &lp, err
+}
+
+// WithWriter sets the custom Writer, which the TaskGroupPrinter renders to.
+func (p TaskGroupPrinter) WithWriter(writer io.Writer) *TaskGroupPrinter {
+	p.Writer = writer
+	return &p
+}
+
+// SetWriter sets the custom Writer, which the TaskGroupPrinter renders to.
+// Nested groups are rendered by their root TaskGroupPrinter, so the Writer of the root is set.
+func (p *TaskGroupPrinter) SetWriter(writer io.Writer) {
+	if p.root == nil {
+		p.Writer = writer
+		return
+	}
+
+	p.root.mu.Lock()
+	defer p.root.mu.Unlock()
+
+	p.root.Writer = writer
+	p.root.area.SetWriter(writer)
+}
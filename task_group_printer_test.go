@@ -0,0 +1,186 @@
+package pterm_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarvinJWendt/testza"
+
+	"github.com/pterm/pterm"
+)
+
+func startTestTaskGroup(t *testing.T, group pterm.TaskGroupPrinter) *pterm.TaskGroupPrinter {
+	t.Helper()
+
+	originalStdout := os.Stdout
+	os.Stdout = os.NewFile(0, os.DevNull) // Set os.Stdout to DevNull to hide output from cursor.Area
+	t.Cleanup(func() {
+		os.Stdout = originalStdout // Restore original os.Stdout
+	})
+
+	g, err := group.Start("Group")
+	testza.AssertNoError(t, err)
+	t.Cleanup(func() {
+		g.Stop()
+	})
+
+	return g
+}
+
+func TestTaskGroupPrinter_AggregatesChildren(t *testing.T) {
+	g := startTestTaskGroup(t, *pterm.DefaultTaskGroup.WithProgressbar(*pterm.DefaultProgressbar.WithShowElapsedTime(false)))
+
+	first, err := g.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(10), "First")
+	testza.AssertNoError(t, err)
+	second, err := g.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(30), "Second")
+	testza.AssertNoError(t, err)
+
+	first.Add(10)
+	second.Add(10)
+
+	s, err := g.Srender()
+	testza.AssertNoError(t, err)
+	lines := strings.Split(pterm.RemoveColorFromString(s), "\n")
+	testza.AssertLen(t, lines, 3)
+	testza.AssertContains(t, lines[0], "Group")
+	testza.AssertContains(t, lines[0], "20/40")
+	testza.AssertContains(t, lines[0], "50%")
+}
+
+func TestTaskGroupPrinter_RendersTree(t *testing.T) {
+	g := startTestTaskGroup(t, pterm.DefaultTaskGroup)
+
+	_, err := g.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(10), "Bar")
+	testza.AssertNoError(t, err)
+	nested, err := g.AddGroup("Nested")
+	testza.AssertNoError(t, err)
+	_, err = nested.AddSpinner(pterm.DefaultSpinner, "Spinner")
+	testza.AssertNoError(t, err)
+
+	// the children render asynchronously into the task group
+	var s string
+	for i := 0; i < 100 && !strings.Contains(s, "Spinner"); i++ {
+		time.Sleep(time.Millisecond * 10)
+		s, _ = g.Srender()
+	}
+
+	lines := strings.Split(pterm.RemoveColorFromString(s), "\n")
+	testza.AssertLen(t, lines, 4)
+	testza.AssertTrue(t, strings.HasPrefix(lines[1], "├─ "), lines[1])
+	testza.AssertTrue(t, strings.HasPrefix(lines[2], "└─ "), lines[2])
+	testza.AssertContains(t, lines[2], "Nested")
+	testza.AssertTrue(t, strings.HasPrefix(lines[3], "   └─ "), lines[3])
+	testza.AssertContains(t, lines[3], "Spinner")
+
+	for _, line := range lines {
+		testza.AssertTrue(t, len([]rune(line)) <= pterm.GetTerminalWidth(), line)
+	}
+}
+
+func TestTaskGroupPrinter_SpinnerCountsWhenStopped(t *testing.T) {
+	g := startTestTaskGroup(t, pterm.DefaultTaskGroup)
+
+	spinner, err := g.AddSpinner(pterm.DefaultSpinner, "Spinner")
+	testza.AssertNoError(t, err)
+	_, err = g.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(1), "Bar")
+	testza.AssertNoError(t, err)
+
+	s, _ := g.Srender()
+	testza.AssertContains(t, pterm.RemoveColorFromString(s), "0/2")
+
+	spinner.Success("Done")
+
+	s, _ = g.Srender()
+	testza.AssertContains(t, pterm.RemoveColorFromString(s), "1/2")
+}
+
+func TestTaskGroupPrinter_IndeterminateChild(t *testing.T) {
+	g := startTestTaskGroup(t, pterm.DefaultTaskGroup)
+
+	_, err := g.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(10), "Known")
+	testza.AssertNoError(t, err)
+	unknown, err := g.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(0), "Unknown")
+	testza.AssertNoError(t, err)
+	unknown.Add(3)
+
+	s, _ := g.Srender()
+	firstLine := strings.Split(pterm.RemoveColorFromString(s), "\n")[0]
+	testza.AssertContains(t, firstLine, "[3]")
+	testza.AssertNotContains(t, firstLine, "%")
+}
+
+func TestTaskGroupPrinter_RemoveWhenDone(t *testing.T) {
+	g := startTestTaskGroup(t, *pterm.DefaultTaskGroup.WithRemoveWhenDone())
+
+	done, err := g.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(5), "Done")
+	testza.AssertNoError(t, err)
+	_, err = g.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(5), "Running")
+	testza.AssertNoError(t, err)
+	done.Add(5)
+
+	s, _ := g.Srender()
+	s = pterm.RemoveColorFromString(s)
+	lines := strings.Split(s, "\n")
+	testza.AssertLen(t, lines, 2)
+	testza.AssertContains(t, lines[0], "5/10")
+	testza.AssertTrue(t, strings.HasPrefix(lines[1], "└─ "), lines[1])
+	testza.AssertNotContains(t, s, "Done")
+}
+
+func TestTaskGroupPrinter_Remove(t *testing.T) {
+	g := startTestTaskGroup(t, pterm.DefaultTaskGroup)
+
+	removed, err := g.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(5), "Removed")
+	testza.AssertNoError(t, err)
+	_, err = g.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(10), "Kept")
+	testza.AssertNoError(t, err)
+	nested, err := g.AddGroup("Nested")
+	testza.AssertNoError(t, err)
+	removed.Add(5)
+
+	g.Remove(removed)
+	g.Remove(nested)
+	g.Remove(&pterm.SpinnerPrinter{})
+
+	s, _ := g.Srender()
+	s = pterm.RemoveColorFromString(s)
+	lines := strings.Split(s, "\n")
+	testza.AssertLen(t, lines, 2)
+	testza.AssertContains(t, lines[0], "0/10")
+	testza.AssertTrue(t, strings.HasPrefix(lines[1], "└─ "), lines[1])
+	testza.AssertNotContains(t, s, "Removed")
+	testza.AssertNotContains(t, s, "Nested")
+
+	removed.Stop()
+}
+
+func TestTaskGroupPrinter_StopStopsChildren(t *testing.T) {
+	g := startTestTaskGroup(t, pterm.DefaultTaskGroup)
+
+	bar, _ := g.AddProgressbar(*pterm.DefaultProgressbar.WithTotal(5), "Bar")
+	spinner, _ := g.AddSpinner(pterm.DefaultSpinner, "Spinner")
+
+	g.Stop()
+
+	testza.AssertFalse(t, g.IsActive)
+	testza.AssertFalse(t, bar.IsActive)
+	testza.AssertFalse(t, spinner.IsActive)
+}
+
+func TestTaskGroupPrinter_NotStarted(t *testing.T) {
+	_, err := pterm.DefaultTaskGroup.AddProgressbar(pterm.DefaultProgressbar)
+	testza.AssertErrorIs(t, err, pterm.ErrTaskGroupNotStarted)
+
+	_, err = pterm.DefaultTaskGroup.AddSpinner(pterm.DefaultSpinner)
+	testza.AssertErrorIs(t, err, pterm.ErrTaskGroupNotStarted)
+
+	_, err = pterm.DefaultTaskGroup.AddGroup("Group")
+	testza.AssertErrorIs(t, err, pterm.ErrTaskGroupNotStarted)
+}
+
+func TestTaskGroupPrinter_InvalidTemplate(t *testing.T) {
+	_, err := pterm.DefaultTaskGroup.WithProgressbar(*pterm.DefaultProgressbar.WithTemplate("{{")).Start()
+	testza.AssertNotNil(t, err)
+}
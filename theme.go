@@ -30,6 +30,7 @@ var (
 		TableStyle:              Style{FgDefault},
 		TableHeaderStyle:        Style{FgLightCyan},
 		TableSeparatorStyle:     Style{FgGray},
+		TableFooterStyle:        Style{FgLightCyan, Bold},
 		HeatmapStyle:            Style{FgDefault},
 		HeatmapHeaderStyle:      Style{FgLightCyan},
 		HeatmapSeparatorStyle:   Style{FgDefault},
@@ -84,6 +85,7 @@ type Theme struct {
 	TableStyle              Style
 	TableHeaderStyle        Style
 	TableSeparatorStyle     Style
+	TableFooterStyle        Style
 	HeatmapStyle            Style
 	HeatmapHeaderStyle      Style
 	HeatmapSeparatorStyle   Style